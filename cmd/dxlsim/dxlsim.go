@@ -0,0 +1,103 @@
+//go:build linux
+// +build linux
+
+// Command dxlsim serves a bus of simulated servos on a Linux pseudo-terminal,
+// so that any program which opens a serial port by path (the examples in this
+// repo, or even Dynamixel Wizard under wine) can talk to them.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/adammck/dynamixel/simulator"
+)
+
+var (
+	axIDs = flag.String("ax", "1", "the IDs of the simulated AX-12 servos (comma-separated)")
+	xlIDs = flag.String("xl", "", "the IDs of the simulated XL-320 servos (comma-separated)")
+	link  = flag.String("link", "", "create a symlink to the serial port at this path")
+)
+
+func main() {
+	flag.Parse()
+
+	bus := simulator.New()
+	for _, m := range []struct {
+		model *simulator.Model
+		ids   string
+	}{
+		{simulator.AX12, *axIDs},
+		{simulator.XL320, *xlIDs},
+	} {
+		ids, err := parseIDs(m.ids)
+		if err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+
+		for _, id := range ids {
+			bus.Add(m.model, id)
+			fmt.Printf("simulating %s with ID %d\n", m.model.Name, id)
+		}
+	}
+
+	pty, err := simulator.ListenPTY(bus)
+	if err != nil {
+		fmt.Printf("pty error: %s\n", err)
+		os.Exit(1)
+	}
+
+	path := pty.Path
+	if *link != "" {
+		os.Remove(*link)
+		err = os.Symlink(pty.Path, *link)
+		if err != nil {
+			fmt.Printf("symlink error: %s\n", err)
+			os.Exit(1)
+		}
+
+		defer os.Remove(*link)
+		path = *link
+	}
+
+	fmt.Printf("serving on: %s\n", path)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- pty.Serve()
+	}()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+
+	select {
+	case <-sig:
+	case err = <-done:
+		fmt.Printf("serve error: %s\n", err)
+	}
+
+	pty.Close()
+}
+
+func parseIDs(s string) ([]int, error) {
+	ids := []int{}
+	if s == "" {
+		return ids, nil
+	}
+
+	for _, str := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(str)
+		if err != nil {
+			return nil, fmt.Errorf("invalid servo ID: %s (err=%s)", str, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
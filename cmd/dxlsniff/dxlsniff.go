@@ -0,0 +1,146 @@
+// Command dxlsniff prints a live trace of the traffic on a Dynamixel bus, to
+// see what some other controller is sending to the servos. It opens the port
+// read-only (on Linux and macOS), so it can be attached to a bus alongside the
+// controller.
+//
+// Both protocols are decoded, and instructions are paired with their responses.
+// Registers are named using the AX-12 map for protocol 1 and the XL-320 map for
+// protocol 2, until the model of a servo is seen. Every model in this repo is
+// known.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/adammck/dynamixel/servo/ex"
+	_ "github.com/adammck/dynamixel/servo/mx"
+	_ "github.com/adammck/dynamixel/servo/pro"
+	_ "github.com/adammck/dynamixel/servo/rx"
+	_ "github.com/adammck/dynamixel/servo/x"
+	"github.com/adammck/dynamixel/sniffer"
+)
+
+var (
+	portName = flag.String("port", "/dev/tty.usbserial-A9ITPZVR", "the serial port path")
+	baudRate = flag.Uint("baud", 1000000, "the baud rate of the bus")
+	ids      = flag.String("id", "", "only show transactions involving these IDs (comma-separated)")
+	capture  = flag.String("capture", "", "also write the raw bytes received to this file")
+	replay   = flag.String("replay", "", "decode a file written by -capture, instead of opening the port")
+)
+
+func main() {
+	flag.Parse()
+
+	filter, err := parseIDs(*ids)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	var r io.Reader
+	if *replay != "" {
+		f, err := os.Open(*replay)
+		if err != nil {
+			fmt.Printf("open error: %s\n", err)
+			os.Exit(1)
+		}
+
+		defer f.Close()
+		r = f
+
+	} else {
+		port, err := openPort(*portName, *baudRate)
+		if err != nil {
+			fmt.Printf("open error: %s\n", err)
+			os.Exit(1)
+		}
+
+		defer port.Close()
+		r = port
+	}
+
+	if *capture != "" {
+		f, err := os.Create(*capture)
+		if err != nil {
+			fmt.Printf("capture error: %s\n", err)
+			os.Exit(1)
+		}
+
+		defer f.Close()
+		r = io.TeeReader(r, f)
+	}
+
+	s := sniffer.New()
+	buf := make([]byte, 1024)
+
+	for {
+		n, err := r.Read(buf)
+
+		// The port returns nothing when the bus has been idle for a while, so
+		// print whatever is pending rather than waiting for the next instruction.
+		ts := s.Feed(time.Now(), buf[:n])
+		if n == 0 || err != nil {
+			ts = append(ts, s.Flush()...)
+		}
+
+		for _, t := range ts {
+			if match(t, filter) {
+				fmt.Println(s.Format(t))
+			}
+		}
+
+		// On a live port, EOF just means that the bus has gone quiet (see
+		// openPort), so keep reading. Only a replay ends.
+		if err == io.EOF {
+			if *replay != "" {
+				return
+			}
+
+			continue
+		}
+		if err != nil {
+			fmt.Printf("read error: %s\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// match returns true if the transaction involves any of the given IDs, or if
+// there aren't any.
+func match(t *sniffer.Transaction, filter map[int]bool) bool {
+	if len(filter) == 0 {
+		return true
+	}
+
+	for _, ID := range t.IDs() {
+		if filter[ID] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseIDs(s string) (map[int]bool, error) {
+	ids := map[int]bool{}
+	if s == "" {
+		return ids, nil
+	}
+
+	for _, str := range strings.Split(s, ",") {
+		ID, err := strconv.Atoi(strings.TrimSpace(str))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID: %s", str)
+		}
+
+		ids[ID] = true
+	}
+
+	return ids, nil
+}
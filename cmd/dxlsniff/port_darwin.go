@@ -0,0 +1,20 @@
+package main
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+const ioctlGetTermios = unix.TIOCGETA
+
+// IOSSIOSPEED sets any baud rate, unlike the speeds in the termios struct. It
+// isn't in x/sys. See IOKit/serial/ioss.h.
+const ioctlIOSSIOSPEED = 0x80045402
+
+func setTermios(fd int, t *unix.Termios, baud uint) error {
+	err := unix.IoctlSetTermios(fd, unix.TIOCSETA, t)
+	if err != nil {
+		return err
+	}
+
+	return unix.IoctlSetPointerInt(fd, ioctlIOSSIOSPEED, int(baud))
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// The termios2 ioctls, which (unlike TCSETS) allow any baud rate.
+const ioctlGetTermios = unix.TCGETS2
+
+func setTermios(fd int, t *unix.Termios, baud uint) error {
+	t.Cflag &^= unix.CBAUD
+	t.Cflag |= unix.BOTHER
+	t.Ispeed = uint32(baud)
+	t.Ospeed = uint32(baud)
+
+	return unix.IoctlSetTermios(fd, unix.TCSETS2, t)
+}
@@ -0,0 +1,23 @@
+//go:build !linux && !darwin
+
+package main
+
+import (
+	"io"
+
+	"github.com/jacobsa/go-serial/serial"
+)
+
+// openPort opens the serial port with go-serial, which can't open it read-only
+// on this platform. It's returned as an io.ReadCloser, so nothing here writes
+// to it.
+func openPort(name string, baud uint) (io.ReadCloser, error) {
+	return serial.Open(serial.OpenOptions{
+		PortName:              name,
+		BaudRate:              baud,
+		DataBits:              8,
+		StopBits:              1,
+		MinimumReadSize:       0,
+		InterCharacterTimeout: 100,
+	})
+}
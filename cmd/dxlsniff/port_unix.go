@@ -0,0 +1,50 @@
+//go:build linux || darwin
+
+package main
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// openPort opens the serial port read-only, so that nothing (not even a bug)
+// can write to the bus, and puts it in raw mode at the given baud rate. Reads
+// return whatever has arrived after 100ms of silence, like the go-serial
+// InterCharacterTimeout which the other commands use.
+func openPort(name string, baud uint) (io.ReadCloser, error) {
+	f, err := os.OpenFile(name, os.O_RDONLY|unix.O_NOCTTY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := int(f.Fd())
+	err = unix.SetNonblock(fd, false)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	t, err := unix.IoctlGetTermios(fd, ioctlGetTermios)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	t.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON | unix.IXOFF
+	t.Oflag &^= unix.OPOST
+	t.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	t.Cflag &^= unix.CSIZE | unix.PARENB | unix.CSTOPB | unix.CRTSCTS
+	t.Cflag |= unix.CS8 | unix.CREAD | unix.CLOCAL
+	t.Cc[unix.VMIN] = 0
+	t.Cc[unix.VTIME] = 1
+
+	err = setTermios(fd, t, baud)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
@@ -3,21 +3,26 @@ package main
 import (
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"time"
 
+	"github.com/adammck/dynamixel/logging"
 	"github.com/adammck/dynamixel/network"
 	"github.com/adammck/dynamixel/servo"
-	"github.com/adammck/dynamixel/servo/ax"
-	"github.com/adammck/dynamixel/servo/xl"
+	_ "github.com/adammck/dynamixel/servo/ax"
+	_ "github.com/adammck/dynamixel/servo/ex"
+	_ "github.com/adammck/dynamixel/servo/mx"
+	_ "github.com/adammck/dynamixel/servo/pro"
+	_ "github.com/adammck/dynamixel/servo/rx"
+	_ "github.com/adammck/dynamixel/servo/x"
+	_ "github.com/adammck/dynamixel/servo/xl"
 	"github.com/jacobsa/go-serial/serial"
 )
 
 var (
 	portName = flag.String("port", "/dev/tty.usbserial-A9ITPZVR", "the serial port path")
 	servoID  = flag.Int("id", 1, "the ID of the servo to flash")
-	model    = flag.String("model", "ax", "the model of the servo to flash")
 	interval = flag.Int("interval", 200, "the time between flashes (ms)")
 	debug    = flag.Bool("debug", false, "show serial traffic")
 )
@@ -42,28 +47,19 @@ func main() {
 
 	network := network.New(serial)
 	if *debug {
-		network.Logger = log.New(os.Stderr, "", log.LstdFlags)
+		network.Logger = logging.New(slog.New(slog.NewTextHandler(os.Stderr, nil)))
 	}
 
 	network.Flush()
 
-	var servo *servo.Servo
-	switch *model {
-	case "ax":
-		servo, err = ax.New(network, *servoID)
-
-	case "xl":
-		servo, err = xl.New(network, *servoID)
-
-	default:
-		fmt.Printf("unsupported servo model: %s\n", *model)
-	}
-
+	servo, err := servo.Detect(network, *servoID)
 	if err != nil {
-		fmt.Printf("servo init error: %s\n", err)
+		fmt.Printf("detect error: %s\n", err)
 		os.Exit(1)
 	}
 
+	fmt.Printf("detected %s\n", servo.Model().Name)
+
 	err = servo.Ping()
 	if err != nil {
 		fmt.Printf("ping error: %s\n", err)
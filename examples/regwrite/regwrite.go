@@ -3,13 +3,16 @@ package main
 import (
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/adammck/dynamixel/logging"
 	"github.com/adammck/dynamixel/network"
 	proto1 "github.com/adammck/dynamixel/protocol/v1"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
 	"github.com/adammck/dynamixel/servo/ax"
 	"github.com/jacobsa/go-serial/serial"
 )
@@ -41,11 +44,16 @@ func main() {
 
 	network := network.New(serial)
 	if *debug {
-		network.Logger = log.New(os.Stderr, "", log.LstdFlags)
+		logger := logging.New(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+		logger.Registers = ax.Registers
+		network.Logger = logger
 	}
 
 	network.Flush()
 
+	proto := proto1.New(network)
+	tx := servo.NewTransaction(proto)
+
 	for _, strID := range strings.Split(*servoIDs, ",") {
 		servoID, err := strconv.Atoi(strID)
 		if err != nil {
@@ -55,33 +63,30 @@ func main() {
 
 		// TODO: Support XL servos, too.
 		//       See examples/set-position.
-		servo, err := ax.New(network, servoID)
+		s, err := ax.New(network, servoID)
 
 		if err != nil {
 			fmt.Printf("servo init error: %s\n", err)
 			os.Exit(1)
 		}
 
-		err = servo.Ping()
+		err = s.Ping()
 		if err != nil {
 			fmt.Printf("ping error: %s\n", err)
 			os.Exit(1)
 		}
 
-		// Send RegWrite instead of WriteData
-		servo.SetBuffered(true)
-
-		err = servo.SetGoalPosition(*position)
+		err = tx.Set(s, reg.GoalPosition, *position)
 		if err != nil {
 			fmt.Printf("move error: %s\n", err)
 			os.Exit(1)
 		}
 	}
 
-	proto := proto1.New(network)
-	err = proto.Action()
+	// Send all of the moves at once. (This uses SYNC_WRITE.)
+	err = tx.Commit()
 	if err != nil {
-		fmt.Printf("action error: %s\n", err)
+		fmt.Printf("commit error: %s\n", err)
 		os.Exit(1)
 	}
 }
@@ -3,9 +3,10 @@ package main
 import (
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 
+	"github.com/adammck/dynamixel/logging"
 	"github.com/adammck/dynamixel/network"
 	"github.com/adammck/dynamixel/servo/xl"
 	"github.com/jacobsa/go-serial/serial"
@@ -38,7 +39,9 @@ func main() {
 
 	network := network.New(serial)
 	if *debug {
-		network.Logger = log.New(os.Stderr, "", log.LstdFlags)
+		logger := logging.New(slog.New(slog.NewTextHandler(os.Stderr, nil)))
+		logger.Registers = xl.Registers
+		network.Logger = logger
 	}
 
 	network.Flush()
@@ -55,31 +58,13 @@ func main() {
 		os.Exit(1)
 	}
 
-	torque, err := servo.TorqueEnable()
-	if err != nil {
-		fmt.Printf("torque enabled error: %s\n", err)
-		os.Exit(1)
-	}
-
-	if torque {
-		err = servo.SetTorqueEnable(false)
-		if err != nil {
-			fmt.Printf("set torque enabled error: %s\n", err)
-			os.Exit(1)
-		}
-	}
+	// The XL-320 won't write to EEPROM while torque is enabled, so disable it
+	// while changing the ID.
+	servo.SetTorqueSafe(true)
 
 	err = servo.SetServoID(*newIdent)
 	if err != nil {
-		fmt.Printf("set return delay time error: %s\n", err)
+		fmt.Printf("set servo ID error: %s\n", err)
 		os.Exit(1)
 	}
-
-	if torque {
-		err = servo.SetTorqueEnable(true)
-		if err != nil {
-			fmt.Printf("set torque enabled error: %s\n", err)
-			os.Exit(1)
-		}
-	}
 }
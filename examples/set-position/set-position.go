@@ -3,9 +3,10 @@ package main
 import (
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 
+	"github.com/adammck/dynamixel/logging"
 	"github.com/adammck/dynamixel/network"
 	"github.com/adammck/dynamixel/servo"
 	"github.com/adammck/dynamixel/servo/ax"
@@ -41,7 +42,7 @@ func main() {
 
 	network := network.New(serial)
 	if *debug {
-		network.Logger = log.New(os.Stderr, "", log.LstdFlags)
+		network.Logger = logging.New(slog.New(slog.NewTextHandler(os.Stderr, nil)))
 	}
 
 	network.Flush()
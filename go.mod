@@ -0,0 +1,16 @@
+module github.com/adammck/dynamixel
+
+go 1.21
+
+require (
+	github.com/jacobsa/go-serial v0.0.0-20180131005756-15cf729a72d4
+	github.com/stretchr/testify v1.9.0
+	go.yaml.in/yaml/v3 v3.0.4
+	golang.org/x/sys v0.15.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
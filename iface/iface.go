@@ -1,10 +1,42 @@
 package iface
 
+import (
+	"time"
+)
+
 // TODO: Use an io.writer instead?
 type Logger interface {
 	Printf(format string, v ...interface{})
 }
 
+// Transaction is a single instruction sent to a servo, and its response. The
+// protocols pass one to their TransactionLogger for each instruction.
+type Transaction struct {
+	Protocol    int
+	ID          int
+	Instruction string
+
+	// The address and number of bytes read or written, if the instruction is a
+	// read or a write. Data is the bytes written, or the bytes read.
+	Address int
+	Length  int
+	Data    []byte
+
+	// The error returned, if any. This is usually a status error from the servo,
+	// or a timeout waiting for its response.
+	Err error
+
+	// The time between sending the instruction, and receiving the response.
+	Latency time.Duration
+}
+
+// TransactionLogger is implemented by loggers which record whole transactions,
+// rather than the individual reads and writes which make them up. See the
+// logging package.
+type TransactionLogger interface {
+	Log(t *Transaction)
+}
+
 // Protocol provides an abstract interface to command servos. This exists so
 // that our abstract Servo type can communicate with actual servos regardless
 // which protocol version they speak.
@@ -32,7 +64,45 @@ type Protocol interface {
 	// FactoryReset() error
 	// Reboot() error
 	// SyncRead() error
-	// SyncWrite() error
 	// BulkRead() error
 	// BulkWrite() error
 }
+
+// SyncReader is implemented by protocols which support the SYNC_READ
+// instruction, which reads the same part of the control table of many servos.
+type SyncReader interface {
+
+	// SyncRead reads length bytes from the given address of each of the given
+	// servo IDs, and returns them by ID. If any of the servos doesn't respond
+	// (or responds with an error), the others are still returned, along with an
+	// error.
+	SyncRead(address int, length int, ids []int) (map[int][]byte, error)
+}
+
+// BulkRead is one part of a BULK_READ instruction.
+type BulkRead struct {
+	ID      int
+	Address int
+	Length  int
+}
+
+// BulkReader is implemented by protocols which support the BULK_READ
+// instruction, which reads a different part of the control table of each of
+// many servos.
+type BulkReader interface {
+
+	// BulkRead performs the given reads, and returns the data by ID. As with
+	// SyncRead, partial results are returned along with an error.
+	BulkRead(reads []BulkRead) (map[int][]byte, error)
+}
+
+// SyncWriter is implemented by protocols which support the SYNC_WRITE
+// instruction. It isn't part of Protocol, so that callers can fall back to
+// RegWrite and Action when it's not available.
+type SyncWriter interface {
+
+	// SyncWrite broadcasts the given data to many servos (by ID) in a single
+	// instruction, which they all execute immediately. The data for each servo
+	// must be the same length. No status packets are sent in response.
+	SyncWrite(address int, data map[int][]byte) error
+}
@@ -0,0 +1,201 @@
+// Package logging provides structured (log/slog) logging of the traffic on a
+// Dynamixel network, with one record per transaction rather than per read.
+//
+// To use it, set the Logger of a network.Network to a *logging.Logger. The
+// protocols log each instruction (and its response) to the network, which
+// passes it on.
+// Low-level chatter from the network itself is logged at debug level.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/adammck/dynamixel/iface"
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Logger writes transactions to an slog.Logger. It implements both iface.Logger
+// and iface.TransactionLogger, so it can be used as the Logger of a
+// network.Network.
+type Logger struct {
+	Logger *slog.Logger
+
+	// Optional register map, used to name the registers which are read from and
+	// written to. Use SetRegisters if servos on the network have different maps.
+	Registers reg.Map
+
+	mu   sync.Mutex
+	byID map[int]reg.Map
+}
+
+// Transaction is a single instruction sent to a servo, and its response.
+type Transaction = iface.Transaction
+
+// New returns a Logger which writes to the given slog.Logger.
+func New(l *slog.Logger) *Logger {
+	return &Logger{
+		Logger: l,
+		byID:   map[int]reg.Map{},
+	}
+}
+
+// SetRegisters sets the register map of the servo with the given ID, which
+// overrides the default Registers.
+func (l *Logger) SetRegisters(ID int, m reg.Map) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.byID[ID] = m
+}
+
+func (l *Logger) registers(ID int) reg.Map {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if m, ok := l.byID[ID]; ok {
+		return m
+	}
+
+	return l.Registers
+}
+
+// Log writes one record for the given transaction. Failed transactions are
+// logged at warning level, and everything else at info.
+func (l *Logger) Log(t *Transaction) {
+	level := slog.LevelInfo
+	if t.Err != nil {
+		level = slog.LevelWarn
+	}
+
+	ctx := context.Background()
+	if !l.Logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.Int("proto", t.Protocol),
+		slog.Int("id", t.ID),
+	}
+
+	if d := direction(t.Instruction); d != "" {
+		attrs = append(attrs, slog.String("dir", d))
+	}
+
+	if t.Length > 0 {
+		attrs = append(attrs, slog.Int("addr", t.Address))
+
+		n, r := l.register(t.ID, t.Address)
+		if r != nil {
+			attrs = append(attrs, slog.String("reg", n.String()))
+		}
+
+		attrs = append(attrs, slog.Int("len", t.Length))
+
+		if len(t.Data) > 0 {
+			if r != nil && r.Length == len(t.Data) {
+				v, _ := r.Decode(t.Data)
+				attrs = append(attrs, slog.Int("value", v))
+			} else if vs := l.values(t); len(vs) > 0 {
+				attrs = append(attrs, slog.Attr{Key: "values", Value: slog.GroupValue(vs...)})
+			} else {
+				attrs = append(attrs, slog.String("data", fmt.Sprintf("% X", t.Data)))
+			}
+		}
+	}
+
+	if t.Err != nil {
+		attrs = append(attrs, slog.String("err", t.Err.Error()))
+	}
+
+	attrs = append(attrs, slog.Duration("latency", t.Latency))
+	l.Logger.LogAttrs(ctx, level, t.Instruction, attrs...)
+}
+
+// direction returns which way the data of the given instruction goes: "write"
+// for data sent to the servo, "read" for data returned by it, or "" for
+// instructions (like PING) which don't carry any.
+func direction(instruction string) string {
+	switch instruction {
+	case "WRITE_DATA", "REG_WRITE", "SYNC_WRITE":
+		return "write"
+
+	case "READ_DATA", "SYNC_READ", "BULK_READ":
+		return "read"
+	}
+
+	return ""
+}
+
+// values decodes every register which lies entirely within the data of the
+// transaction, e.g. when GoalPosition and MovingSpeed are written together.
+// Returns nothing if the data isn't that of a single servo, or if it covers
+// bytes which aren't part of any known register.
+func (l *Logger) values(t *Transaction) []slog.Attr {
+	if len(t.Data) != t.Length {
+		return nil
+	}
+
+	type named struct {
+		n reg.RegName
+		r *reg.Register
+	}
+
+	var rs []named
+	for n, r := range l.registers(t.ID) {
+		if r.Address >= t.Address && r.Address+r.Length <= t.Address+len(t.Data) {
+			rs = append(rs, named{n, r})
+		}
+	}
+
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].r.Address < rs[j].r.Address
+	})
+
+	var out []slog.Attr
+	addr := t.Address
+	for _, x := range rs {
+		if x.r.Address != addr {
+			return nil
+		}
+
+		v, err := x.r.Decode(t.Data[addr-t.Address : addr-t.Address+x.r.Length])
+		if err != nil {
+			return nil
+		}
+
+		out = append(out, slog.Int(x.n.String(), v))
+		addr += x.r.Length
+	}
+
+	if addr != t.Address+len(t.Data) {
+		return nil
+	}
+
+	return out
+}
+
+// register returns the register at the given address of the given servo, if
+// its register map is known.
+func (l *Logger) register(ID, addr int) (reg.RegName, *reg.Register) {
+	for n, r := range l.registers(ID) {
+		if r.Address == addr {
+			return n, r
+		}
+	}
+
+	return 0, nil
+}
+
+// Printf writes a debug message. This is used by the network to log individual
+// reads and writes, which is mostly noise unless something is broken.
+func (l *Logger) Printf(format string, v ...interface{}) {
+	if !l.Logger.Enabled(context.Background(), slog.LevelDebug) {
+		return
+	}
+
+	l.Logger.Debug(strings.TrimSpace(fmt.Sprintf(format, v...)))
+}
@@ -0,0 +1,91 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func logger(level slog.Level) (*bytes.Buffer, *Logger) {
+	buf := &bytes.Buffer{}
+	h := slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+
+	return buf, New(slog.New(h))
+}
+
+func TestLog(t *testing.T) {
+	buf, l := logger(slog.LevelInfo)
+	l.Registers = reg.Map{
+		reg.GoalPosition: {0x1e, 2, reg.RW, 0, 1023, false, reg.None, 0, reg.RAM},
+		reg.MovingSpeed:  {0x20, 2, reg.RW, 0, 1023, false, reg.None, 0, reg.RAM},
+	}
+
+	examples := []struct {
+		tx  Transaction
+		out string
+	}{
+		{
+			Transaction{Protocol: 1, ID: 1, Instruction: "PING", Latency: time.Millisecond},
+			"level=INFO msg=PING proto=1 id=1 latency=1ms\n",
+		},
+		{
+			Transaction{Protocol: 1, ID: 2, Instruction: "WRITE_DATA", Address: 0x1e, Length: 2, Data: []byte{0x00, 0x02}},
+			"level=INFO msg=WRITE_DATA proto=1 id=2 dir=write addr=30 reg=GoalPosition len=2 value=512 latency=0s\n",
+		},
+		{
+			Transaction{Protocol: 2, ID: 3, Instruction: "READ_DATA", Address: 0x1e, Length: 6, Data: []byte{1, 2, 3, 4, 5, 6}},
+			"level=INFO msg=READ_DATA proto=2 id=3 dir=read addr=30 reg=GoalPosition len=6 data=\"01 02 03 04 05 06\" latency=0s\n",
+		},
+		{
+			Transaction{Protocol: 1, ID: 5, Instruction: "REG_WRITE", Address: 0x1e, Length: 4, Data: []byte{0x00, 0x02, 0x64, 0x00}},
+			"level=INFO msg=REG_WRITE proto=1 id=5 dir=write addr=30 reg=GoalPosition len=4 values.GoalPosition=512 values.MovingSpeed=100 latency=0s\n",
+		},
+		{
+			Transaction{Protocol: 2, ID: 4, Instruction: "READ_DATA", Address: 0x10, Length: 1, Err: errors.New("read timed out")},
+			"level=WARN msg=READ_DATA proto=2 id=4 dir=read addr=16 len=1 err=\"read timed out\" latency=0s\n",
+		},
+	}
+
+	for _, eg := range examples {
+		buf.Reset()
+		l.Log(&eg.tx)
+		assert.Equal(t, eg.out, buf.String())
+	}
+}
+
+func TestSetRegisters(t *testing.T) {
+	buf, l := logger(slog.LevelInfo)
+	l.SetRegisters(2, reg.Map{
+		reg.Led: {0x19, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+	})
+
+	l.Log(&Transaction{Protocol: 1, ID: 1, Instruction: "WRITE_DATA", Address: 0x19, Length: 1, Data: []byte{1}})
+	assert.NotContains(t, buf.String(), "reg=Led")
+
+	buf.Reset()
+	l.Log(&Transaction{Protocol: 1, ID: 2, Instruction: "WRITE_DATA", Address: 0x19, Length: 1, Data: []byte{1}})
+	assert.Contains(t, buf.String(), "reg=Led len=1 value=1")
+}
+
+func TestPrintf(t *testing.T) {
+	buf, l := logger(slog.LevelInfo)
+	l.Printf("~~ n=%d\n", 1)
+	assert.Equal(t, "", buf.String())
+
+	buf, l = logger(slog.LevelDebug)
+	l.Printf("~~ n=%d\n", 1)
+	assert.Equal(t, "level=DEBUG msg=\"~~ n=1\"\n", buf.String())
+}
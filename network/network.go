@@ -21,7 +21,8 @@ type Network struct {
 	Timeout time.Duration
 
 	// Optional Logger (which only implements Printf) to log network traffic. If
-	// nil (the default), nothing is logged.
+	// nil (the default), nothing is logged. If it also implements
+	// iface.TransactionLogger, whole transactions are passed to it by Log.
 	Logger iface.Logger
 }
 
@@ -66,12 +67,12 @@ func (nw *Network) Read(p []byte) (n int, err error) {
 		}
 	}
 
-	nw.Logf("<< %#v\n", p)
+	nw.Logf("<< % X\n", p)
 	return n, nil
 }
 
 func (nw *Network) Write(p []byte) (int, error) {
-	nw.Logf(">> %#v\n", p)
+	nw.Logf(">> % X\n", p)
 	return nw.Serial.Write(p)
 }
 
@@ -81,7 +82,7 @@ func (nw *Network) Flush() {
 
 	for {
 		n, _ = nw.Serial.Read(buf)
-		nw.Logf(".. %v\n", buf)
+		nw.Logf(".. % X\n", buf[:n])
 		if n == 0 {
 			break
 		}
@@ -94,3 +95,18 @@ func (nw *Network) Logf(format string, v ...interface{}) {
 		nw.Logger.Printf(format, v...)
 	}
 }
+
+// Log writes a transaction to the network logger, unless it's nil. Loggers which
+// don't implement iface.TransactionLogger get a one-line summary via Printf.
+func (nw *Network) Log(t *iface.Transaction) {
+	if nw.Logger == nil {
+		return
+	}
+
+	if l, ok := nw.Logger.(iface.TransactionLogger); ok {
+		l.Log(t)
+		return
+	}
+
+	nw.Logger.Printf("%s v%d id=%d addr=%d len=%d err=%v (%s)\n", t.Instruction, t.Protocol, t.ID, t.Address, t.Length, t.Err, t.Latency)
+}
@@ -1,8 +1,10 @@
 package v1
 
 import (
+	"errors"
 	"testing"
 
+	reg "github.com/adammck/dynamixel/registers"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -25,7 +27,18 @@ func TestDecodeError(t *testing.T) {
 	}
 
 	for _, eg := range examples {
-		act := decodeError(eg.input)
+		act := DecodeError(eg.input)
 		assert.EqualError(t, act, eg.output)
 	}
 }
+
+func TestStatusError(t *testing.T) {
+	err := DecodeError(0x24)
+
+	var se *StatusError
+	if assert.True(t, errors.As(err, &se)) {
+		assert.True(t, se.Flags.Has(reg.Overload))
+		assert.True(t, se.Flags.Has(reg.Overheating))
+		assert.False(t, se.Flags.Has(reg.InputVoltage))
+	}
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+
+	// ErrIncomplete is returned by ParsePacket when the buffer contains the
+	// start of a packet, but not all of it. The caller should wait for more
+	// bytes and try again.
+	ErrIncomplete = errors.New("incomplete packet")
+
+	// ErrChecksum is returned (along with the packet) by ParsePacket when the
+	// checksum of an otherwise well-formed packet doesn't match.
+	ErrChecksum = errors.New("checksum mismatch")
+)
+
+// Packet is a single instruction or status packet. Both share the same framing,
+// so for status packets, the Instruction field contains the error bits.
+//
+// See: http://support.robotis.com/en/product/dynamixel/communication/dxl_packet.htm
+type Packet struct {
+	ID          int
+	Instruction byte
+	Params      []byte
+}
+
+// Bytes returns the packet encoded for the wire, including the header and the
+// checksum.
+func (pkt *Packet) Bytes() []byte {
+	id := byte(pkt.ID & 0xFF)
+	pLen := byte(len(pkt.Params) + 2)
+
+	b := make([]byte, 0, len(pkt.Params)+6)
+	b = append(b,
+		0xFF,            // header
+		0xFF,            // header
+		id,              // target Dynamixel ID
+		pLen,            // len(params) + 2
+		pkt.Instruction, // instruction type (read/write/etc)
+	)
+	b = append(b, pkt.Params...)
+
+	return append(b, checksum(b[2:]))
+}
+
+// ParsePacket decodes the packet at the start of b, and returns it along with
+// the number of bytes which it occupied. The buffer must start with a header.
+func ParsePacket(b []byte) (*Packet, int, error) {
+	if len(b) < 2 {
+		return nil, 0, ErrIncomplete
+	}
+
+	if b[0] != 0xFF || b[1] != 0xFF {
+		return nil, 0, fmt.Errorf("bad packet header: 0x%02X 0x%02X", b[0], b[1])
+	}
+
+	if len(b) < 4 {
+		return nil, 0, ErrIncomplete
+	}
+
+	if b[3] < 2 {
+		return nil, 0, fmt.Errorf("bad packet length: %d", b[3])
+	}
+
+	n := int(b[3]) + 4
+	if len(b) < n {
+		return nil, 0, ErrIncomplete
+	}
+
+	pkt := &Packet{
+		ID:          int(b[2]),
+		Instruction: b[4],
+		Params:      append([]byte{}, b[5:n-1]...),
+	}
+
+	if checksum(b[2:n-1]) != b[n-1] {
+		return pkt, n, ErrChecksum
+	}
+
+	return pkt, n, nil
+}
+
+// checksum returns the checksum of the given bytes, which should be everything
+// in a packet except the header and the checksum itself.
+func checksum(b []byte) byte {
+	var sum byte
+	for _, v := range b {
+		sum += v
+	}
+
+	return ^sum
+}
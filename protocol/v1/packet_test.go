@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPacket(t *testing.T) {
+	pkt := Packet{1, Ping, []byte{2, 3, 4}}
+	buf := []byte{0xff, 0xff, 0x01, 0x05, 0x01, 0x02, 0x03, 0x04, 0xef}
+	assert.Equal(t, buf, pkt.Bytes())
+
+	act, n, err := ParsePacket(append(buf, 0xff, 0xff))
+	if assert.NoError(t, err) {
+		assert.Equal(t, len(buf), n)
+		assert.Equal(t, pkt, *act)
+	}
+
+	_, _, err = ParsePacket(buf[:5])
+	assert.Equal(t, ErrIncomplete, err)
+
+	_, _, err = ParsePacket([]byte{0xff, 0x00, 0x01})
+	assert.EqualError(t, err, "bad packet header: 0xFF 0x00")
+
+	buf[8] = 0x00
+	_, n, err = ParsePacket(buf)
+	assert.Equal(t, ErrChecksum, err)
+	assert.Equal(t, 9, n)
+}
@@ -1,10 +1,12 @@
 package v1
 
 import (
-	"bytes"
 	"fmt"
 	"io"
+	"sort"
+	"time"
 
+	"github.com/adammck/dynamixel/iface"
 	"github.com/adammck/dynamixel/utils"
 )
 
@@ -23,14 +25,45 @@ const (
 	BroadcastIdent int = 0xFE // 254
 )
 
+var instructionNames = map[byte]string{
+	Ping:      "PING",
+	ReadData:  "READ_DATA",
+	WriteData: "WRITE_DATA",
+	RegWrite:  "REG_WRITE",
+	Action:    "ACTION",
+	Reset:     "RESET",
+	SyncWrite: "SYNC_WRITE",
+}
+
+// InstructionName returns the name of the given instruction, as it's written
+// in the docs (e.g. READ_DATA).
+func InstructionName(instruction byte) string {
+	if s, ok := instructionNames[instruction]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("0x%02X", instruction)
+}
+
 type Proto1 struct {
 	Network io.ReadWriter
+
+	// Optional logger, which is passed each transaction. New sets it to the
+	// network, if that implements iface.TransactionLogger (as network.Network
+	// does). If nil, nothing is logged.
+	Logger iface.TransactionLogger
 }
 
 func New(network io.ReadWriter) *Proto1 {
-	return &Proto1{
+	p := &Proto1{
 		Network: network,
 	}
+
+	if l, ok := network.(iface.TransactionLogger); ok {
+		p.Logger = l
+	}
+
+	return p
 }
 
 func (p *Proto1) SetBuffered(buffered bool) {
@@ -43,33 +76,14 @@ func (p *Proto1) SetBuffered(buffered bool) {
 // * http://support.robotis.com/en/product/dynamixel/communication/dxl_instruction.htm
 
 func (p *Proto1) writeInstruction(ident int, instruction byte, params []byte) error {
-	buf := new(bytes.Buffer)
-	id := byte(ident & 0xFF)
-	pLen := byte(len(params) + 2)
-
-	// build instruction packet
-
-	buf.Write([]byte{
-		0xFF,        // header
-		0xFF,        // header
-		id,          // target Dynamixel ID
-		pLen,        // len(params) + 2
-		instruction, // instruction type (read/write/etc)
-	})
-
-	buf.Write(params)
-
-	// calculate checksum
-
-	sum := id + pLen + instruction
-	for _, value := range params {
-		sum += value
+	pkt := &Packet{
+		ID:          ident,
+		Instruction: instruction,
+		Params:      params,
 	}
 
-	buf.WriteByte(byte((^sum) & 0xFF))
-
 	// write to port
-	_, err := buf.WriteTo(p.Network)
+	_, err := p.Network.Write(pkt.Bytes())
 	if err != nil {
 		return err
 	}
@@ -157,7 +171,7 @@ func (p *Proto1) readStatusPacket(expID int) ([]byte, error) {
 	// return an error if the packet contained one.
 
 	if errBits != 0x0 {
-		return []byte{}, decodeError(errBits)
+		return []byte{}, DecodeError(errBits)
 	}
 
 	// return an error if we received a packet with the wrong ID. this indicates
@@ -175,6 +189,7 @@ func (p *Proto1) readStatusPacket(expID int) ([]byte, error) {
 // Ping sends the PING instruction to the given Servo ID, and waits for the
 // response. Returns an error if the ping fails, or nil if it succeeds.
 func (p *Proto1) Ping(ident int) error {
+	start := time.Now()
 	err := p.writeInstruction(ident, Ping, nil)
 	if err != nil {
 		return err
@@ -183,6 +198,7 @@ func (p *Proto1) Ping(ident int) error {
 	// There's no way to disable the status packet for PING commands, so always
 	// wait for it. That's how we know that the servo is responding.
 	_, err = p.readStatusPacket(ident)
+	p.log(start, &iface.Transaction{ID: ident, Instruction: "PING", Err: err})
 	if err != nil {
 		return err
 	}
@@ -194,17 +210,23 @@ func (p *Proto1) Ping(ident int) error {
 // servo ID. Use the bytesToInt function to convert the output to something more
 // useful.
 func (p *Proto1) ReadData(ident int, addr int, count int) ([]byte, error) {
+	if addr > 0xFF {
+		return []byte{}, fmt.Errorf("address out of range: %d", addr)
+	}
+
 	params := []byte{
 		utils.Low(addr),
 		byte(count),
 	}
 
+	start := time.Now()
 	err := p.writeInstruction(ident, ReadData, params)
 	if err != nil {
 		return []byte{}, err
 	}
 
 	buf, err := p.readStatusPacket(ident)
+	p.log(start, &iface.Transaction{ID: ident, Instruction: "READ_DATA", Address: addr, Length: count, Data: buf, Err: err})
 	if err != nil {
 		return buf, err
 	}
@@ -221,12 +243,16 @@ func (p *Proto1) RegWrite(ident int, address int, data []byte, expectResponse bo
 }
 
 func (p *Proto1) write(ident int, instruction byte, address int, data []byte, expectResponse bool) error {
+	if address > 0xFF {
+		return fmt.Errorf("address out of range: %d", address)
+	}
 
 	// Params is dest address followed by the data.
 	ps := make([]byte, len(data)+1)
 	ps[0] = utils.Low(address)
 	copy(ps[1:], data)
 
+	start := time.Now()
 	err := p.writeInstruction(ident, instruction, ps)
 	if err != nil {
 		return err
@@ -234,17 +260,80 @@ func (p *Proto1) write(ident int, instruction byte, address int, data []byte, ex
 
 	if expectResponse {
 		_, err = p.readStatusPacket(ident)
-		if err != nil {
-			return err
+	}
+
+	p.log(start, &iface.Transaction{ID: ident, Instruction: InstructionName(instruction), Address: address, Length: len(data), Data: data, Err: err})
+	return err
+}
+
+// SyncWrite broadcasts the SYNC_WRITE instruction, which writes the given data
+// (by servo ID) to the same address of many servos at once. The data for each
+// servo must be the same length. See iface.SyncWriter.
+func (p *Proto1) SyncWrite(address int, data map[int][]byte) error {
+	if address > 0xFF {
+		return fmt.Errorf("address out of range: %d", address)
+	}
+
+	ids, n, err := syncData(data)
+	if err != nil {
+		return err
+	}
+
+	// Params are the address and length, then the ID and data of each servo.
+	ps := []byte{utils.Low(address), byte(n)}
+	for _, id := range ids {
+		ps = append(ps, byte(id))
+		ps = append(ps, data[id]...)
+	}
+
+	start := time.Now()
+	err = p.writeInstruction(BroadcastIdent, SyncWrite, ps)
+	p.log(start, &iface.Transaction{ID: BroadcastIdent, Instruction: "SYNC_WRITE", Address: address, Length: n, Data: ps[2:], Err: err})
+	return err
+}
+
+// syncData returns the IDs of the given sync write data in order, and the
+// length of the data for each, which must be the same.
+func syncData(data map[int][]byte) ([]int, int, error) {
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("no data to write")
+	}
+
+	ids := make([]int, 0, len(data))
+	for id := range data {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	n := len(data[ids[0]])
+	for _, id := range ids {
+		if len(data[id]) != n {
+			return nil, 0, fmt.Errorf("data for servo %d is %d bytes, expected %d", id, len(data[id]), n)
 		}
 	}
 
-	return nil
+	return ids, n, nil
 }
 
 // Action broadcasts the ACTION instruction, which initiates any previously
 // bufferred instructions. Doesn't wait for a status packet in response, because
 // they are not sent in response to broadcast instructions.
 func (p *Proto1) Action() error {
-	return p.writeInstruction(BroadcastIdent, Action, nil)
+	start := time.Now()
+	err := p.writeInstruction(BroadcastIdent, Action, nil)
+	p.log(start, &iface.Transaction{ID: BroadcastIdent, Instruction: "ACTION", Err: err})
+	return err
+}
+
+// log writes the given transaction to the logger, if there is one. See the
+// logging package.
+func (p *Proto1) log(start time.Time, t *iface.Transaction) {
+	if p.Logger == nil {
+		return
+	}
+
+	t.Protocol = 1
+	t.Latency = time.Since(start)
+	p.Logger.Log(t)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/adammck/dynamixel/iface"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,3 +19,55 @@ func TestProtoWriteInstruction(t *testing.T) {
 		assert.Equal(t, []byte{0xff, 0xff, 0x01, 0x05, 0x01, 0x02, 0x03, 0x04, 0xef}, b.Bytes())
 	}
 }
+
+func TestAddressOutOfRange(t *testing.T) {
+	b := &bytes.Buffer{}
+	p := New(b)
+
+	_, err := p.ReadData(1, 0x100, 1)
+	assert.EqualError(t, err, "address out of range: 256")
+
+	err = p.WriteData(1, 0x100, []byte{1}, false)
+	assert.EqualError(t, err, "address out of range: 256")
+	assert.Empty(t, b.Bytes())
+}
+
+func TestSyncWrite(t *testing.T) {
+	b := &bytes.Buffer{}
+	p := New(b)
+
+	err := p.SyncWrite(0x1e, map[int][]byte{2: {0x10, 0x20}, 1: {0x30, 0x40}})
+	assert.NoError(t, err)
+
+	//                     header----  id--  len-  inst  addr  n---  id--  data------  id--  data------  chk-
+	assert.Equal(t, []byte{0xff, 0xff, 0xfe, 0x0a, 0x83, 0x1e, 0x02, 0x01, 0x30, 0x40, 0x02, 0x10, 0x20, 0xb1}, b.Bytes())
+
+	err = p.SyncWrite(0x1e, map[int][]byte{1: {0x10}, 2: {0x10, 0x20}})
+	assert.EqualError(t, err, "data for servo 2 is 2 bytes, expected 1")
+}
+
+type txLog []*iface.Transaction
+
+func (l *txLog) Log(t *iface.Transaction) {
+	*l = append(*l, t)
+}
+
+func TestLogger(t *testing.T) {
+	b := &bytes.Buffer{}
+	p := New(b)
+	assert.Nil(t, p.Logger)
+
+	// Any io.ReadWriter works, as long as the logger is set.
+	l := &txLog{}
+	p.Logger = l
+
+	err := p.SyncWrite(0x1e, map[int][]byte{1: {0x10, 0x20}})
+	assert.NoError(t, err)
+	if assert.Len(t, *l, 1) {
+		tx := (*l)[0]
+		assert.Equal(t, 1, tx.Protocol)
+		assert.Equal(t, "SYNC_WRITE", tx.Instruction)
+		assert.Equal(t, 0x1e, tx.Address)
+		assert.Equal(t, 2, tx.Length)
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"fmt"
 )
 
-func decodeError(b byte) error {
+// DecodeError converts an error byte (as included in a status packet) into an
+// error object with a friendly error message.
+//
+// See: http://support.robotis.com/en/product/dynamixel_pro/communication/instruction_status_packet.htm
+func DecodeError(b byte) error {
 	s := ""
 
 	switch b {
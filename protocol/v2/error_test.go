@@ -23,7 +23,7 @@ func TestDecodeError(t *testing.T) {
 	}
 
 	for _, eg := range examples {
-		act := decodeError(eg.input)
+		act := DecodeError(eg.input)
 		assert.EqualError(t, act, eg.output)
 	}
 }
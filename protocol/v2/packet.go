@@ -0,0 +1,119 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+
+	// ErrIncomplete is returned by ParsePacket when the buffer contains the
+	// start of a packet, but not all of it. The caller should wait for more
+	// bytes and try again.
+	ErrIncomplete = errors.New("incomplete packet")
+
+	// ErrChecksum is returned (along with the packet) by ParsePacket when the
+	// CRC of an otherwise well-formed packet doesn't match.
+	ErrChecksum = errors.New("crc mismatch")
+)
+
+// Packet is a single instruction or status packet. Status packets have the
+// Status instruction, and their first param is the error byte.
+//
+// See: http://support.robotis.com/en/product/dynamixel_pro/communication/instruction_status_packet.htm
+type Packet struct {
+	ID          int
+	Instruction byte
+	Params      []byte
+}
+
+// Bytes returns the packet encoded for the wire, including the header, byte
+// stuffing, and the CRC.
+func (pkt *Packet) Bytes() []byte {
+	params := stuff(pkt.Params)
+	pLen := len(params) + 3
+
+	b := make([]byte, 0, len(params)+10)
+	b = append(b,
+		0xFF,                 // Header
+		0xFF,                 // Header
+		0xFD,                 // Header
+		0x00,                 // Reserved
+		byte(pkt.ID&0xFF),    // target ID
+		byte(pLen&0xFF),      // LSB: len(params) + 3
+		byte((pLen>>8)&0xFF), // MSB: len(params) + 3
+		pkt.Instruction,      // instruction type (see const section)
+	)
+	b = append(b, params...)
+
+	crc := CRC(b)
+	return append(b, byte(crc&0xFF), byte((crc>>8)&0xFF))
+}
+
+// ParsePacket decodes the packet at the start of b, and returns it along with
+// the number of bytes which it occupied. The buffer must start with a header.
+func ParsePacket(b []byte) (*Packet, int, error) {
+	for i, h := range []byte{0xFF, 0xFF, 0xFD} {
+		if len(b) <= i {
+			return nil, 0, ErrIncomplete
+		}
+		if b[i] != h {
+			return nil, 0, fmt.Errorf("bad packet header: % X", b[:i+1])
+		}
+	}
+
+	if len(b) < 7 {
+		return nil, 0, ErrIncomplete
+	}
+
+	pLen := int(b[5]) | int(b[6])<<8
+	if pLen < 3 {
+		return nil, 0, fmt.Errorf("bad packet length: %d", pLen)
+	}
+
+	n := pLen + 7
+	if len(b) < n {
+		return nil, 0, ErrIncomplete
+	}
+
+	pkt := &Packet{
+		ID:          int(b[4]),
+		Instruction: b[7],
+		Params:      unstuff(b[8 : n-2]),
+	}
+
+	crc := CRC(b[:n-2])
+	if byte(crc&0xFF) != b[n-2] || byte((crc>>8)&0xFF) != b[n-1] {
+		return pkt, n, ErrChecksum
+	}
+
+	return pkt, n, nil
+}
+
+// stuff returns a copy of the given params with an extra 0xFD inserted after
+// every occurrence of the header (0xFF 0xFF 0xFD), so it can't be mistaken for
+// the start of a new packet.
+func stuff(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i, v := range b {
+		out = append(out, v)
+		if v == 0xFD && i >= 2 && b[i-1] == 0xFF && b[i-2] == 0xFF {
+			out = append(out, 0xFD)
+		}
+	}
+
+	return out
+}
+
+// unstuff reverses stuff.
+func unstuff(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		out = append(out, b[i])
+		if b[i] == 0xFD && i >= 2 && b[i-1] == 0xFF && b[i-2] == 0xFF && i+1 < len(b) && b[i+1] == 0xFD {
+			i++
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPacket(t *testing.T) {
+	examples := []struct {
+		pkt Packet
+		buf []byte
+	}{
+		{
+			Packet{1, WriteData, []byte{0x02, 0x00, 0x03, 0x04, 0x05}},
+			[]byte{0xFF, 0xFF, 0xFD, 0x00, 0x01, 0x08, 0x00, 0x03, 0x02, 0x00, 0x03, 0x04, 0x05, 0x3D, 0x30},
+		},
+		{
+			// Header inside params is stuffed.
+			Packet{1, WriteData, []byte{0xFF, 0xFF, 0xFD, 0x01}},
+			[]byte{0xFF, 0xFF, 0xFD, 0x00, 0x01, 0x08, 0x00, 0x03, 0xFF, 0xFF, 0xFD, 0xFD, 0x01, 0xC1, 0x0E},
+		},
+	}
+
+	for _, eg := range examples {
+		buf := eg.pkt.Bytes()
+		assert.Equal(t, eg.buf, buf)
+
+		pkt, n, err := ParsePacket(append(buf, 0x99))
+		if assert.NoError(t, err) {
+			assert.Equal(t, len(eg.buf), n)
+			assert.Equal(t, eg.pkt, *pkt)
+		}
+	}
+
+	_, _, err := ParsePacket([]byte{0xFF, 0xFF, 0xFD, 0x00, 0x01, 0x08})
+	assert.Equal(t, ErrIncomplete, err)
+
+	_, _, err = ParsePacket([]byte{0xFF, 0xFF, 0xFE})
+	assert.EqualError(t, err, "bad packet header: FF FF FE")
+
+	_, n, err := ParsePacket([]byte{0xFF, 0xFF, 0xFD, 0x00, 0x01, 0x03, 0x00, 0x01, 0x00, 0x00})
+	assert.Equal(t, ErrChecksum, err)
+	assert.Equal(t, 10, n)
+}
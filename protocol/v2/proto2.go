@@ -1,11 +1,12 @@
 package v2
 
 import (
-	"bytes"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
+	"github.com/adammck/dynamixel/iface"
 	"github.com/adammck/dynamixel/network"
 )
 
@@ -29,14 +30,50 @@ const (
 	BroadcastIdent int = 0xFE // 254
 )
 
+var instructionNames = map[byte]string{
+	Ping:         "PING",
+	ReadData:     "READ_DATA",
+	WriteData:    "WRITE_DATA",
+	RegWrite:     "REG_WRITE",
+	Action:       "ACTION",
+	FactoryReset: "FACTORY_RESET",
+	Reboot:       "REBOOT",
+	Status:       "STATUS",
+	SyncRead:     "SYNC_READ",
+	SyncWrite:    "SYNC_WRITE",
+	BulkRead:     "BULK_READ",
+	BulkWrite:    "BULK_WRITE",
+}
+
+// InstructionName returns the name of the given instruction, as it's written
+// in the docs (e.g. READ_DATA).
+func InstructionName(instruction byte) string {
+	if s, ok := instructionNames[instruction]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("0x%02X", instruction)
+}
+
 type Proto2 struct {
 	Network io.ReadWriter
+
+	// Optional logger, which is passed each transaction. New sets it to the
+	// network, if that implements iface.TransactionLogger (as network.Network
+	// does). If nil, nothing is logged.
+	Logger iface.TransactionLogger
 }
 
 func New(network io.ReadWriter) *Proto2 {
-	return &Proto2{
+	p := &Proto2{
 		Network: network,
 	}
+
+	if l, ok := network.(iface.TransactionLogger); ok {
+		p.Logger = l
+	}
+
+	return p
 }
 
 func (p *Proto2) SetBuffered(buffered bool) {
@@ -46,9 +83,6 @@ func (p *Proto2) SetBuffered(buffered bool) {
 // See:
 // http://support.robotis.com/en/product/dynamixel_pro/communication/instruction_status_packet.htm
 func (p *Proto2) writeInstruction(ident int, instruction byte, params []byte) error {
-	buf := new(bytes.Buffer)
-	id := byte(ident & 0xFF)
-	pLen := len(params) + 3
 
 	// +------+------+------+----------+----+-------+-------+-------------+--------+-----+--------+-------+-------+
 	// | 0xFF | 0xFF | 0xFD |   0x00   | ID | LEN_L | LEN_H |    INST     | Param1 | ... | ParamN | CRL_L | CRL_H |
@@ -56,28 +90,14 @@ func (p *Proto2) writeInstruction(ident int, instruction byte, params []byte) er
 	// |       Header       | Reserved | ID | Packet Length | Instruction |       Parameter       |   16bit CRC   |
 	// +--------------------+----------+----+---------------+-------------+-----------------------+---------------+
 
-	buf.Write([]byte{
-		0xFF,                     // Header
-		0xFF,                     // Header
-		0xFD,                     // Header
-		0x00,                     // Reserved
-		id,                       // target ID
-		byte(pLen & 0xFF),        // LSB: len(params) + 3
-		byte((pLen >> 8) & 0xFF), // MSB: len(params) + 3
-		instruction,              // instruction type (see const section)
-	})
-
-	// append n params
-	buf.Write(params)
-
-	// calculate checksum
-	// TODO: Return two bytes from CRC rather than uint16?
-	b := CRC(buf.Bytes())
-	buf.WriteByte(byte(b & 0xFF))        // LSB
-	buf.WriteByte(byte((b >> 8) & 0xFF)) // MSB
+	pkt := &Packet{
+		ID:          ident,
+		Instruction: instruction,
+		Params:      params,
+	}
 
 	// write to port
-	_, err := buf.WriteTo(p.Network)
+	_, err := p.Network.Write(pkt.Bytes())
 	if err != nil {
 		return err
 	}
@@ -152,7 +172,7 @@ func (p *Proto2) readStatusPacket(expID int) ([]byte, error) {
 	// Return an error if the packet contained one.
 
 	if errByte != 0 {
-		return nil, decodeError(errByte)
+		return nil, DecodeError(errByte)
 	}
 
 	// Return an error if we received a packet with the wrong ID. This indicates
@@ -180,6 +200,7 @@ func (p *Proto2) Ping(ident int) error {
 		}()
 	}
 
+	start := time.Now()
 	err := p.writeInstruction(ident, Ping, nil)
 	if err != nil {
 		return err
@@ -188,6 +209,7 @@ func (p *Proto2) Ping(ident int) error {
 	// There's no way to disable the status packet for PING commands, so always
 	// wait for it. That's how we know that the servo is responding.
 	_, err = p.readStatusPacket(ident)
+	p.log(start, &iface.Transaction{ID: ident, Instruction: "PING", Err: err})
 	if err != nil {
 		return err
 	}
@@ -206,12 +228,14 @@ func (p *Proto2) ReadData(ident int, addr int, n int) ([]byte, error) {
 		byte((n >> 8) & 0xFF),    // MSB
 	}
 
+	start := time.Now()
 	err := p.writeInstruction(ident, ReadData, params)
 	if err != nil {
 		return []byte{}, err
 	}
 
 	buf, err := p.readStatusPacket(ident)
+	p.log(start, &iface.Transaction{ID: ident, Instruction: "READ_DATA", Address: addr, Length: n, Data: buf, Err: err})
 	if err != nil {
 		return buf, err
 	}
@@ -233,6 +257,7 @@ func (p *Proto2) write(ident int, instruction byte, addr int, data []byte, expec
 	ps[1] = byte((addr >> 8) & 0xFF) // MSB
 	copy(ps[2:], data)
 
+	start := time.Now()
 	err := p.writeInstruction(ident, instruction, ps)
 	if err != nil {
 		return err
@@ -240,17 +265,159 @@ func (p *Proto2) write(ident int, instruction byte, addr int, data []byte, expec
 
 	if expectResponse {
 		_, err = p.readStatusPacket(ident)
+	}
+
+	p.log(start, &iface.Transaction{ID: ident, Instruction: InstructionName(instruction), Address: addr, Length: len(data), Data: data, Err: err})
+	return err
+}
+
+// SyncRead broadcasts the SYNC_READ instruction, which reads n bytes from the
+// same address of each of the given servos. Each responds in turn, in the order
+// given. See iface.SyncReader.
+func (p *Proto2) SyncRead(address int, n int, ids []int) (map[int][]byte, error) {
+	ps := []byte{
+		byte(address & 0xFF),
+		byte((address >> 8) & 0xFF),
+		byte(n & 0xFF),
+		byte((n >> 8) & 0xFF),
+	}
+
+	for _, id := range ids {
+		ps = append(ps, byte(id))
+	}
+
+	start := time.Now()
+	err := p.writeInstruction(BroadcastIdent, SyncRead, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := p.readStatusPackets(ids)
+	p.log(start, &iface.Transaction{ID: BroadcastIdent, Instruction: "SYNC_READ", Address: address, Length: n, Err: err})
+	return out, err
+}
+
+// BulkRead broadcasts the BULK_READ instruction, which reads a different part
+// of the control table from each servo. See iface.BulkReader.
+func (p *Proto2) BulkRead(reads []iface.BulkRead) (map[int][]byte, error) {
+	var ps []byte
+	ids := make([]int, len(reads))
+
+	for i, r := range reads {
+		ps = append(ps,
+			byte(r.ID),
+			byte(r.Address&0xFF),
+			byte((r.Address>>8)&0xFF),
+			byte(r.Length&0xFF),
+			byte((r.Length>>8)&0xFF))
+
+		ids[i] = r.ID
+	}
+
+	start := time.Now()
+	err := p.writeInstruction(BroadcastIdent, BulkRead, ps)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := p.readStatusPackets(ids)
+	p.log(start, &iface.Transaction{ID: BroadcastIdent, Instruction: "BULK_READ", Err: err})
+	return out, err
+}
+
+// readStatusPackets reads one status packet from each of the given servo IDs,
+// in order, and returns their params by ID. Returns the first error, if any,
+// along with the params which were read.
+func (p *Proto2) readStatusPackets(ids []int) (map[int][]byte, error) {
+	out := make(map[int][]byte, len(ids))
+	var first error
+
+	for _, id := range ids {
+		b, err := p.readStatusPacket(id)
 		if err != nil {
-			return err
+			if first == nil {
+				first = fmt.Errorf("servo %d: %s", id, err)
+			}
+
+			continue
 		}
+
+		out[id] = b
 	}
 
-	return nil
+	return out, first
+}
+
+// SyncWrite broadcasts the SYNC_WRITE instruction, which writes the given data
+// (by servo ID) to the same address of many servos at once. The data for each
+// servo must be the same length. See iface.SyncWriter.
+func (p *Proto2) SyncWrite(address int, data map[int][]byte) error {
+	ids, n, err := syncData(data)
+	if err != nil {
+		return err
+	}
+
+	// Params are the address and length, then the ID and data of each servo.
+	ps := []byte{
+		byte(address & 0xFF),
+		byte((address >> 8) & 0xFF),
+		byte(n & 0xFF),
+		byte((n >> 8) & 0xFF),
+	}
+
+	for _, id := range ids {
+		ps = append(ps, byte(id))
+		ps = append(ps, data[id]...)
+	}
+
+	start := time.Now()
+	err = p.writeInstruction(BroadcastIdent, SyncWrite, ps)
+	p.log(start, &iface.Transaction{ID: BroadcastIdent, Instruction: "SYNC_WRITE", Address: address, Length: n, Data: ps[4:], Err: err})
+	return err
+}
+
+// syncData returns the IDs of the given sync write data in order, and the
+// length of the data for each, which must be the same.
+func syncData(data map[int][]byte) ([]int, int, error) {
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("no data to write")
+	}
+
+	ids := make([]int, 0, len(data))
+	for id := range data {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	n := len(data[ids[0]])
+	for _, id := range ids {
+		if len(data[id]) != n {
+			return nil, 0, fmt.Errorf("data for servo %d is %d bytes, expected %d", id, len(data[id]), n)
+		}
+	}
+
+	return ids, n, nil
 }
 
 // Action broadcasts the ACTION instruction, which initiates any previously
 // bufferred instructions. Doesn't wait for a status packet in response, because
 // they are not sent in response to broadcast instructions.
 func (p *Proto2) Action() error {
-	return p.writeInstruction(BroadcastIdent, Action, nil)
+	start := time.Now()
+	err := p.writeInstruction(BroadcastIdent, Action, nil)
+	p.log(start, &iface.Transaction{ID: BroadcastIdent, Instruction: "ACTION", Err: err})
+	return err
+}
+
+// log writes the given transaction to the logger, if there is one. See the
+// logging package.
+func (p *Proto2) log(start time.Time, t *iface.Transaction) {
+	if p.Logger == nil {
+		return
+	}
+
+	t.Protocol = 2
+	t.Latency = time.Since(start)
+	p.Logger.Log(t)
 }
@@ -5,6 +5,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/adammck/dynamixel/iface"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -96,3 +97,53 @@ func TestProto2WriteData(t *testing.T) {
 	}
 
 }
+
+func TestProto2SyncWrite(t *testing.T) {
+	w := &bytes.Buffer{}
+	p := New(&RW{bytes.NewReader(nil), w})
+
+	err := p.SyncWrite(116, map[int][]byte{2: {1, 2, 3, 4}, 1: {5, 6, 7, 8}})
+	assert.NoError(t, err)
+
+	pkt, _, err := ParsePacket(w.Bytes())
+	if assert.NoError(t, err) {
+		assert.Equal(t, BroadcastIdent, pkt.ID)
+		assert.Equal(t, SyncWrite, pkt.Instruction)
+		assert.Equal(t, []byte{116, 0, 4, 0, 1, 5, 6, 7, 8, 2, 1, 2, 3, 4}, pkt.Params)
+	}
+}
+
+func TestProto2SyncRead(t *testing.T) {
+	status := func(id int, params ...byte) []byte {
+		return (&Packet{ID: id, Instruction: Status, Params: append([]byte{0}, params...)}).Bytes()
+	}
+
+	r := bytes.NewReader(append(status(1, 0x10, 0x20), status(2, 0x30, 0x40)...))
+	w := &bytes.Buffer{}
+	p := New(&RW{r, w})
+
+	out, err := p.SyncRead(132, 2, []int{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int][]byte{1: {0x10, 0x20}, 2: {0x30, 0x40}}, out)
+
+	pkt, _, err := ParsePacket(w.Bytes())
+	if assert.NoError(t, err) {
+		assert.Equal(t, SyncRead, pkt.Instruction)
+		assert.Equal(t, []byte{132, 0, 2, 0, 1, 2}, pkt.Params)
+	}
+
+	// servo 2 doesn't respond
+	r = bytes.NewReader(status(1, 0x10))
+	w = &bytes.Buffer{}
+	p = New(&RW{r, w})
+
+	out, err = p.BulkRead([]iface.BulkRead{{1, 37, 1}, {2, 132, 4}})
+	assert.EqualError(t, err, "servo 2: reading packet header: EOF")
+	assert.Equal(t, map[int][]byte{1: {0x10}}, out)
+
+	pkt, _, err = ParsePacket(w.Bytes())
+	if assert.NoError(t, err) {
+		assert.Equal(t, BulkRead, pkt.Instruction)
+		assert.Equal(t, []byte{1, 37, 0, 1, 0, 2, 132, 0, 4, 0}, pkt.Params)
+	}
+}
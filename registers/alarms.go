@@ -0,0 +1,116 @@
+package registers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AlarmFlags is a set of error conditions, as reported in the error byte of
+// protocol 1 status packets, and in the AlarmLed, AlarmShutdown and
+// HardwareErrorStatus registers. The low byte is the same as the protocol 1
+// error byte; models which use other bits in their registers translate them
+// via AlarmBits.
+type AlarmFlags uint16
+
+const (
+	InputVoltage AlarmFlags = 1 << iota
+	AngleLimit
+	Overheating
+	Range
+	Checksum
+	Overload
+	Instruction
+	unknown // Reserved; never set by a healthy servo
+
+	// Only reported by the HardwareErrorStatus of newer models.
+	MotorEncoder
+	ElectricalShock
+	HallSensor
+)
+
+var alarmNames = []string{
+	"input voltage",
+	"angle limit",
+	"overheating",
+	"range",
+	"checksum",
+	"overload",
+	"instruction",
+	"unknown",
+	"motor encoder",
+	"electrical shock",
+	"hall sensor",
+}
+
+// Has returns true if all of the given flags are set.
+func (f AlarmFlags) Has(flags AlarmFlags) bool {
+	return f&flags == flags
+}
+
+// Names returns the names of the flags which are set, e.g. "overheating".
+func (f AlarmFlags) Names() []string {
+	out := []string{}
+	for i, n := range alarmNames {
+		if f&(1<<uint(i)) != 0 {
+			out = append(out, n)
+		}
+	}
+
+	return out
+}
+
+func (f AlarmFlags) String() string {
+	if f == 0 {
+		return "none"
+	}
+
+	return strings.Join(f.Names(), ", ")
+}
+
+// AlarmBits is the flag which each bit of a model's alarm registers represents,
+// for models which don't use the same bits as the protocol 1 error byte. The
+// zero value means that they do.
+type AlarmBits [8]AlarmFlags
+
+// Decode returns the flags represented by the given register value.
+func (b AlarmBits) Decode(v int) AlarmFlags {
+	if b == (AlarmBits{}) {
+		return AlarmFlags(v & 0xFF)
+	}
+
+	var f AlarmFlags
+	for i, flag := range b {
+		if v&(1<<uint(i)) != 0 {
+			f |= flag
+		}
+	}
+
+	return f
+}
+
+// Encode returns the register value which represents the given flags, or an
+// error if any of them can't be represented.
+func (b AlarmBits) Encode(f AlarmFlags) (int, error) {
+	if b == (AlarmBits{}) {
+		if f > 0xFF {
+			return 0, fmt.Errorf("unsupported alarms: %s", f&^0xFF)
+		}
+
+		return int(f), nil
+	}
+
+	v := 0
+	rest := f
+	for i, flag := range b {
+		if flag != 0 && f.Has(flag) {
+			v |= 1 << uint(i)
+			rest &^= flag
+		}
+	}
+
+	if rest != 0 {
+		return 0, fmt.Errorf("unsupported alarms: %s", rest)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,39 @@
+package registers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlarmFlags(t *testing.T) {
+	f := InputVoltage | Overload
+	assert.True(t, f.Has(Overload))
+	assert.False(t, f.Has(Overload|Range))
+	assert.Equal(t, "input voltage, overload", f.String())
+	assert.Equal(t, "none", AlarmFlags(0).String())
+	assert.Equal(t, []string{"instruction", "unknown"}, AlarmFlags(0xC0).Names())
+}
+
+func TestAlarmBits(t *testing.T) {
+
+	// the zero value is the protocol 1 layout
+	b := AlarmBits{}
+	assert.Equal(t, Overheating|Checksum, b.Decode(0x14))
+	v, err := b.Encode(Overheating | Checksum)
+	assert.NoError(t, err)
+	assert.Equal(t, 0x14, v)
+
+	_, err = b.Encode(MotorEncoder)
+	assert.EqualError(t, err, "unsupported alarms: motor encoder")
+
+	// the XL-320 layout
+	b = AlarmBits{Overload, Overheating, InputVoltage}
+	assert.Equal(t, Overload|InputVoltage, b.Decode(0x05))
+	v, err = b.Encode(Overheating)
+	assert.NoError(t, err)
+	assert.Equal(t, 0x02, v)
+
+	_, err = b.Encode(AngleLimit | Overload)
+	assert.EqualError(t, err, "unsupported alarms: angle limit")
+}
@@ -1,15 +1,23 @@
 package registers
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adammck/dynamixel/utils"
+)
+
 //go:generate stringer -type=RegName
 type RegName int
 type Access int
+type Area int
 type Map map[RegName]*Register
 
 type Register struct {
 
-	// TODO: Make address a plain int, since proto1 wants a byte, and proto2
-	//       wants a uint16. We have to cast it either way.
-	Address byte
+	// The address in the control table. This is a plain int, since proto1 wants
+	// a byte, and proto2 wants a uint16.
+	Address int
 
 	Length int
 	Access Access
@@ -18,6 +26,83 @@ type Register struct {
 	// is the register is RW.
 	Min int
 	Max int
+
+	// Whether the value is signed (two's complement), like the goal current or
+	// homing offset of newer servos. Older servos use sign-magnitude for their
+	// few signed values (e.g. AX-12 PresentLoad), which are not Signed.
+	Signed bool
+
+	// The physical unit of the value, and the size of one step in that unit.
+	// For example, the PresentVoltage of most servos is in Volts with a Scale of
+	// 0.1, so a value of 95 is 9.5 volts.
+	Unit  Unit
+	Scale float64
+
+	// Where the register is stored. EEPROM registers persist when the servo is
+	// power-cycled, but have limited write cycles, and some servos (e.g. the
+	// X-series) refuse to write them while torque is enabled.
+	Area Area
+}
+
+// Indirect describes the indirect address region of a control table, which
+// newer servos (e.g. the X-series) have to allow scattered registers to be
+// read or written in one go. Each of the Count indirect addresses (starting at
+// Address) is two bytes, and contains the address of the register byte which
+// the corresponding byte of indirect data (starting at Data) refers to. The
+// zero value means that there is no such region.
+type Indirect struct {
+	Address int
+	Data    int
+	Count   int
+}
+
+// Copy returns a deep copy of the map, so that the registers of one model can be
+// derived from another without modifying it.
+func (m Map) Copy() Map {
+	out := Map{}
+	for n, r := range m {
+		c := *r
+		out[n] = &c
+	}
+
+	return out
+}
+
+// Decode returns the value of the register from the given bytes, which must be
+// exactly Length long.
+func (r *Register) Decode(b []byte) (int, error) {
+	if len(b) != r.Length {
+		return 0, fmt.Errorf("expected %d bytes, got %d", r.Length, len(b))
+	}
+
+	if r.Signed {
+		return utils.BytesToSignedInt(b)
+	}
+
+	return utils.BytesToInt(b)
+}
+
+// Encode returns the bytes to write to the register to set it to the given
+// value. Returns an error if the value can't be represented in Length bytes.
+// This doesn't check Min and Max; that's up to the caller.
+func (r *Register) Encode(v int) ([]byte, error) {
+	if r.Length != 1 && r.Length != 2 && r.Length != 4 {
+		return nil, fmt.Errorf("invalid register length: %d", r.Length)
+	}
+
+	// Use int64, so the bounds of 4-byte registers don't overflow on platforms
+	// where int is 32 bits.
+	bits := uint(r.Length * 8)
+	min, max := int64(0), int64(1)<<bits-1
+	if r.Signed {
+		min, max = -1<<(bits-1), 1<<(bits-1)-1
+	}
+
+	if int64(v) < min || int64(v) > max {
+		return nil, fmt.Errorf("value out of range for %d-byte register: %d", r.Length, v)
+	}
+
+	return utils.IntToBytes(v, r.Length), nil
 }
 
 const (
@@ -65,9 +150,75 @@ const (
 	GoalVelocity
 	GoalTorque
 
+	ModelInformation    // X-series
+	DriveMode           // X-series
+	OperatingMode       // X-series
+	SecondaryID         // X-series
+	ProtocolType        // X-series
+	HomingOffset        // X-series
+	MovingThreshold     // X-series
+	PWMLimit            // X-series
+	CurrentLimit        // X-series
+	VelocityLimit       // X-series
+	ExternalPortMode1   // XM540
+	ExternalPortMode2   // XM540
+	ExternalPortMode3   // XM540
+	VelocityIGain       // X-series
+	VelocityPGain       // X-series
+	Feedforward2ndGain  // X-series
+	Feedforward1stGain  // X-series
+	BusWatchdog         // X-series
+	GoalPWM             // X-series
+	GoalCurrent         // X-series
+	ProfileAcceleration // X-series
+	ProfileVelocity     // X-series
+	RealtimeTick        // X-series
+	MovingStatus        // X-series
+	PresentPWM          // X-series
+	PresentCurrent      // X-series
+	VelocityTrajectory  // X-series
+	PositionTrajectory  // X-series
+	ExternalPortData1   // XM540
+	ExternalPortData2   // XM540
+	ExternalPortData3   // XM540
+
+	MultiTurnOffset         // MX
+	ResolutionDivider       // MX
+	GoalAcceleration        // MX
+	TorqueControlModeEnable // MX-64, MX-106
+
+	SensedCurrent // EX-106+
+
+	AccelerationLimit // PRO, P-series
+	ExternalPortMode4 // PRO, P-series
+	ExternalPortData4 // PRO, P-series
+	LedRed            // PRO, P-series
+	LedGreen          // PRO, P-series
+	LedBlue           // PRO, P-series
+
 	// Access Levels specify whether a register is hard-coded into the servo
 	// (e.g. the model number), or is a value which can be changed (e.g. the
 	// identity). The zero-value is RO.
 	RO Access = 0
 	RW Access = 1
+
+	// Areas specify whether a register is stored in RAM, and so is reset when
+	// the servo is power-cycled, or persisted in EEPROM. The zero-value is RAM.
+	RAM    Area = 0
+	EEPROM Area = 1
 )
+
+// ByName returns the register with the given name (e.g. "GoalPosition"),
+// ignoring case.
+func ByName(name string) (RegName, bool) {
+	for n := RegName(0); ; n++ {
+		s := n.String()
+		if strings.HasPrefix(s, "RegName(") {
+			return 0, false
+		}
+
+		if strings.EqualFold(s, name) {
+			return n, true
+		}
+	}
+}
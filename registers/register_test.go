@@ -0,0 +1,78 @@
+package registers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	r := &Register{Length: 2}
+	v, err := r.Decode([]byte{0xff, 0xff})
+	assert.NoError(t, err)
+	assert.Equal(t, 65535, v)
+
+	r.Signed = true
+	v, err = r.Decode([]byte{0xff, 0xff})
+	assert.NoError(t, err)
+	assert.Equal(t, -1, v)
+
+	r = &Register{Length: 4, Signed: true}
+	v, err = r.Decode([]byte{0xf0, 0xd2, 0xfc, 0xff})
+	assert.NoError(t, err)
+	assert.Equal(t, -208144, v)
+
+	_, err = r.Decode([]byte{0x00, 0x00})
+	assert.EqualError(t, err, "expected 4 bytes, got 2")
+}
+
+func TestEncode(t *testing.T) {
+	r := &Register{Length: 1}
+	b, err := r.Encode(255)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xff}, b)
+
+	_, err = r.Encode(256)
+	assert.EqualError(t, err, "value out of range for 1-byte register: 256")
+
+	_, err = r.Encode(-1)
+	assert.Error(t, err)
+
+	r = &Register{Length: 4, Signed: true}
+	b, err = r.Encode(-208144)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xf0, 0xd2, 0xfc, 0xff}, b)
+
+	b, err = r.Encode(2147483647)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0xff, 0xff, 0x7f}, b)
+
+	_, err = r.Encode(2147483648)
+	assert.Error(t, err)
+
+	r = &Register{Length: 3}
+	_, err = r.Encode(0)
+	assert.EqualError(t, err, "invalid register length: 3")
+}
+
+func TestCopy(t *testing.T) {
+	m := Map{ServoID: {0x03, 1, RW, 0, 252, false, None, 0, EEPROM}}
+	c := m.Copy()
+	c[ServoID].Max = 253
+
+	assert.Equal(t, 252, m[ServoID].Max)
+	assert.Equal(t, 253, c[ServoID].Max)
+}
+
+func TestByName(t *testing.T) {
+	n, ok := ByName("GoalPosition")
+	assert.True(t, ok)
+	assert.Equal(t, GoalPosition, n)
+
+	n, ok = ByName("presentposition")
+	assert.True(t, ok)
+	assert.Equal(t, PresentPosition, n)
+
+	_, ok = ByName("Nonsense")
+	assert.False(t, ok)
+}
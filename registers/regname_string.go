@@ -1,16 +1,104 @@
-// Code generated by "stringer -type=RegName"; DO NOT EDIT
+// Code generated by "stringer -type=RegName"; DO NOT EDIT.
 
 package registers
 
-import "fmt"
+import "strconv"
 
-const _RegName_name = "ModelNumberFirmwareVersionServoIDBaudRateReturnDelayTimeCwAngleLimitCcwAngleLimitHighestLimitTemperatureLowestLimitVoltageHighestLimitVoltageMaxTorqueStatusReturnLevelAlarmLedAlarmShutdownTorqueEnableLedCwComplianceMarginCcwComplianceMarginCwComplianceSlopeCcwComplianceSlopeGoalPositionMovingSpeedTorqueLimitPresentPositionPresentSpeedPresentLoadPresentVoltagePresentTemperatureRegisteredMovingLockPunch"
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ModelNumber-0]
+	_ = x[FirmwareVersion-1]
+	_ = x[ServoID-2]
+	_ = x[BaudRate-3]
+	_ = x[ReturnDelayTime-4]
+	_ = x[CwAngleLimit-5]
+	_ = x[CcwAngleLimit-6]
+	_ = x[HighestLimitTemperature-7]
+	_ = x[LowestLimitVoltage-8]
+	_ = x[HighestLimitVoltage-9]
+	_ = x[MaxTorque-10]
+	_ = x[StatusReturnLevel-11]
+	_ = x[AlarmLed-12]
+	_ = x[AlarmShutdown-13]
+	_ = x[TorqueEnable-14]
+	_ = x[Led-15]
+	_ = x[CwComplianceMargin-16]
+	_ = x[CcwComplianceMargin-17]
+	_ = x[CwComplianceSlope-18]
+	_ = x[CcwComplianceSlope-19]
+	_ = x[GoalPosition-20]
+	_ = x[MovingSpeed-21]
+	_ = x[TorqueLimit-22]
+	_ = x[PresentPosition-23]
+	_ = x[PresentSpeed-24]
+	_ = x[PresentLoad-25]
+	_ = x[PresentVoltage-26]
+	_ = x[PresentTemperature-27]
+	_ = x[RegisteredInstruction-28]
+	_ = x[Moving-29]
+	_ = x[Lock-30]
+	_ = x[Punch-31]
+	_ = x[ControlMode-32]
+	_ = x[DGain-33]
+	_ = x[IGain-34]
+	_ = x[PGain-35]
+	_ = x[HardwareErrorStatus-36]
+	_ = x[GoalVelocity-37]
+	_ = x[GoalTorque-38]
+	_ = x[ModelInformation-39]
+	_ = x[DriveMode-40]
+	_ = x[OperatingMode-41]
+	_ = x[SecondaryID-42]
+	_ = x[ProtocolType-43]
+	_ = x[HomingOffset-44]
+	_ = x[MovingThreshold-45]
+	_ = x[PWMLimit-46]
+	_ = x[CurrentLimit-47]
+	_ = x[VelocityLimit-48]
+	_ = x[ExternalPortMode1-49]
+	_ = x[ExternalPortMode2-50]
+	_ = x[ExternalPortMode3-51]
+	_ = x[VelocityIGain-52]
+	_ = x[VelocityPGain-53]
+	_ = x[Feedforward2ndGain-54]
+	_ = x[Feedforward1stGain-55]
+	_ = x[BusWatchdog-56]
+	_ = x[GoalPWM-57]
+	_ = x[GoalCurrent-58]
+	_ = x[ProfileAcceleration-59]
+	_ = x[ProfileVelocity-60]
+	_ = x[RealtimeTick-61]
+	_ = x[MovingStatus-62]
+	_ = x[PresentPWM-63]
+	_ = x[PresentCurrent-64]
+	_ = x[VelocityTrajectory-65]
+	_ = x[PositionTrajectory-66]
+	_ = x[ExternalPortData1-67]
+	_ = x[ExternalPortData2-68]
+	_ = x[ExternalPortData3-69]
+	_ = x[MultiTurnOffset-70]
+	_ = x[ResolutionDivider-71]
+	_ = x[GoalAcceleration-72]
+	_ = x[TorqueControlModeEnable-73]
+	_ = x[SensedCurrent-74]
+	_ = x[AccelerationLimit-75]
+	_ = x[ExternalPortMode4-76]
+	_ = x[ExternalPortData4-77]
+	_ = x[LedRed-78]
+	_ = x[LedGreen-79]
+	_ = x[LedBlue-80]
+}
+
+const _RegName_name = "ModelNumberFirmwareVersionServoIDBaudRateReturnDelayTimeCwAngleLimitCcwAngleLimitHighestLimitTemperatureLowestLimitVoltageHighestLimitVoltageMaxTorqueStatusReturnLevelAlarmLedAlarmShutdownTorqueEnableLedCwComplianceMarginCcwComplianceMarginCwComplianceSlopeCcwComplianceSlopeGoalPositionMovingSpeedTorqueLimitPresentPositionPresentSpeedPresentLoadPresentVoltagePresentTemperatureRegisteredInstructionMovingLockPunchControlModeDGainIGainPGainHardwareErrorStatusGoalVelocityGoalTorqueModelInformationDriveModeOperatingModeSecondaryIDProtocolTypeHomingOffsetMovingThresholdPWMLimitCurrentLimitVelocityLimitExternalPortMode1ExternalPortMode2ExternalPortMode3VelocityIGainVelocityPGainFeedforward2ndGainFeedforward1stGainBusWatchdogGoalPWMGoalCurrentProfileAccelerationProfileVelocityRealtimeTickMovingStatusPresentPWMPresentCurrentVelocityTrajectoryPositionTrajectoryExternalPortData1ExternalPortData2ExternalPortData3MultiTurnOffsetResolutionDividerGoalAccelerationTorqueControlModeEnableSensedCurrentAccelerationLimitExternalPortMode4ExternalPortData4LedRedLedGreenLedBlue"
 
-var _RegName_index = [...]uint16{0, 11, 26, 33, 41, 56, 68, 81, 104, 122, 141, 150, 167, 175, 188, 200, 203, 221, 240, 257, 275, 287, 298, 309, 324, 336, 347, 361, 379, 389, 395, 399, 404}
+var _RegName_index = [...]uint16{0, 11, 26, 33, 41, 56, 68, 81, 104, 122, 141, 150, 167, 175, 188, 200, 203, 221, 240, 257, 275, 287, 298, 309, 324, 336, 347, 361, 379, 400, 406, 410, 415, 426, 431, 436, 441, 460, 472, 482, 498, 507, 520, 531, 543, 555, 570, 578, 590, 603, 620, 637, 654, 667, 680, 698, 716, 727, 734, 745, 764, 779, 791, 803, 813, 827, 845, 863, 880, 897, 914, 929, 946, 962, 985, 998, 1015, 1032, 1049, 1055, 1063, 1070}
 
 func (i RegName) String() string {
-	if i < 0 || i >= RegName(len(_RegName_index)-1) {
-		return fmt.Sprintf("RegName(%d)", i)
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_RegName_index)-1 {
+		return "RegName(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _RegName_name[_RegName_index[i]:_RegName_index[i+1]]
+	return _RegName_name[_RegName_index[idx]:_RegName_index[idx+1]]
 }
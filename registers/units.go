@@ -0,0 +1,73 @@
+package registers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Unit is the physical unit of the value of a register.
+type Unit string
+
+const (
+	None         Unit = "" // Not a physical quantity (e.g. an ID or a bitfield)
+	Degrees      Unit = "deg"
+	RPM          Unit = "rpm"
+	Volts        Unit = "V"
+	Celsius      Unit = "°C"
+	Milliamps    Unit = "mA"
+	Microseconds Unit = "µs"
+	Milliseconds Unit = "ms"
+	Percent      Unit = "%"
+)
+
+var units = map[string]Unit{
+	"degrees":      Degrees,
+	"rpm":          RPM,
+	"volts":        Volts,
+	"celsius":      Celsius,
+	"milliamps":    Milliamps,
+	"microseconds": Microseconds,
+	"milliseconds": Milliseconds,
+	"percent":      Percent,
+}
+
+// ParseUnit returns the unit with the given symbol (e.g. "V") or name (e.g.
+// "volts"). The empty string is None.
+func ParseUnit(s string) (Unit, error) {
+	if u, ok := units[strings.ToLower(s)]; ok {
+		return u, nil
+	}
+
+	for _, u := range units {
+		if string(u) == s {
+			return u, nil
+		}
+	}
+
+	if s == "" {
+		return None, nil
+	}
+
+	return None, fmt.Errorf("unknown unit: %s", s)
+}
+
+// ToPhysical converts a value of the register to its unit, by multiplying it by
+// Scale. Values of registers with no unit (or no scale) are returned as-is.
+func (r *Register) ToPhysical(v int) float64 {
+	if r.Unit == None || r.Scale == 0 {
+		return float64(v)
+	}
+
+	return float64(v) * r.Scale
+}
+
+// FromPhysical converts a value in the unit of the register to the nearest
+// value of the register. This is the inverse of ToPhysical.
+func (r *Register) FromPhysical(f float64) int {
+	if r.Unit == None || r.Scale == 0 {
+		return int(math.Round(f))
+	}
+
+	return int(math.Round(f / r.Scale))
+}
@@ -0,0 +1,36 @@
+package registers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnit(t *testing.T) {
+	for s, exp := range map[string]Unit{
+		"":        None,
+		"V":       Volts,
+		"volts":   Volts,
+		"Degrees": Degrees,
+		"°C":      Celsius,
+		"%":       Percent,
+	} {
+		u, err := ParseUnit(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, exp, u, s)
+	}
+
+	_, err := ParseUnit("furlongs")
+	assert.EqualError(t, err, "unknown unit: furlongs")
+}
+
+func TestPhysical(t *testing.T) {
+	r := &Register{Unit: RPM, Scale: 0.229}
+	assert.InDelta(t, 22.9, r.ToPhysical(100), 0.0001)
+	assert.Equal(t, 100, r.FromPhysical(22.9))
+	assert.Equal(t, -44, r.FromPhysical(-10))
+
+	r = &Register{}
+	assert.Equal(t, 7.0, r.ToPhysical(7))
+	assert.Equal(t, 7, r.FromPhysical(7.2))
+}
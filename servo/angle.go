@@ -0,0 +1,35 @@
+package servo
+
+import (
+	"fmt"
+	"math"
+)
+
+// Angle is an angle of a servo, relative to its origin (see SetOrigin). It's
+// stored in degrees, so untyped constants (e.g. MoveTo(90)) are degrees, but
+// Radians can be used to convert from radians.
+type Angle float64
+
+// Degrees returns an angle of the given number of degrees.
+func Degrees(d float64) Angle {
+	return Angle(d)
+}
+
+// Radians returns an angle of the given number of radians.
+func Radians(r float64) Angle {
+	return Angle(r * 180 / math.Pi)
+}
+
+// Degrees returns the angle in degrees.
+func (a Angle) Degrees() float64 {
+	return float64(a)
+}
+
+// Radians returns the angle in radians.
+func (a Angle) Radians() float64 {
+	return float64(a) * math.Pi / 180
+}
+
+func (a Angle) String() string {
+	return fmt.Sprintf("%.2f°", float64(a))
+}
@@ -0,0 +1,101 @@
+package servo
+
+import (
+	"math"
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAngleUnits(t *testing.T) {
+	assert.InDelta(t, 90, Radians(math.Pi/2).Degrees(), 1e-9)
+	assert.InDelta(t, math.Pi, Degrees(180).Radians(), 1e-9)
+	assert.Equal(t, "-45.50°", Degrees(-45.5).String())
+}
+
+func TestMoveTo(t *testing.T) {
+	m := reg.Map{
+		reg.GoalPosition:    {0x1e, 2, reg.RW, 0, 4095, false, reg.Degrees, 0.088, reg.RAM},
+		reg.PresentPosition: {0x24, 2, reg.RO, 0, 4095, false, reg.Degrees, 0.088, reg.RAM},
+	}
+	for n, r := range wheelRegisters {
+		m[n] = r
+	}
+
+	m[reg.CwAngleLimit] = &reg.Register{Address: 0x06, Length: 2, Access: reg.RW, Max: 4095, Area: reg.EEPROM}
+	m[reg.CcwAngleLimit] = &reg.Register{Address: 0x08, Length: 2, Access: reg.RW, Max: 4095, Area: reg.EEPROM}
+
+	p, s := servo(m, map[int]byte{
+		0x06: 0x00, 0x07: 0x04, // 1024
+		0x08: 0x00, 0x09: 0x0c, // 3072
+	})
+
+	goal := func() int {
+		return int(p.controlTable[0x1e]) | int(p.controlTable[0x1f])<<8
+	}
+
+	// The model must be known to convert anything.
+	err := s.MoveTo(0)
+	assert.EqualError(t, err, "can't convert angle of servo of unknown model")
+
+	// Like the MX-28: 4096 steps over a whole turn.
+	s.model = &Model{Steps: 4096, Range: 360, Center: 2048}
+
+	err = s.MoveTo(45)
+	assert.NoError(t, err)
+	assert.Equal(t, 2560, goal())
+
+	// The angle limits (which also say which mode it's in) are read together.
+	reads := p.reads
+	err = s.MoveTo(45)
+	assert.NoError(t, err)
+	assert.Equal(t, reads+1, p.reads)
+
+	err = s.MoveTo(Radians(-math.Pi / 4))
+	assert.NoError(t, err)
+	assert.Equal(t, 1536, goal())
+
+	// Beyond the angle limits (+/- 90 degrees) is clamped, not wrapped.
+	err = s.MoveTo(270)
+	assert.NoError(t, err)
+	assert.Equal(t, 3072, goal())
+
+	err = s.MoveTo(-135)
+	assert.NoError(t, err)
+	assert.Equal(t, 1024, goal())
+
+	s.SetOrigin(10)
+	err = s.MoveTo(0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2162, goal())
+
+	p.controlTable[0x24], p.controlTable[0x25] = 0x00, 0x08 // 2048
+	a, err := s.Angle()
+	assert.NoError(t, err)
+	assert.InDelta(t, -10, a.Degrees(), 1e-9)
+
+	// With the cache, they're not read at all.
+	s.SetCached(true)
+	s.MoveTo(0)
+	reads = p.reads
+	err = s.MoveTo(0)
+	assert.NoError(t, err)
+	assert.Equal(t, reads, p.reads)
+	s.SetCached(false)
+
+	// Like the AX-12: 1023 steps over 300 degrees. SetZero is relative to
+	// position zero, so 150 is (roughly) the center.
+	s.model = &Model{Steps: 1023, Range: 300, Center: 512}
+	s.SetZero(150)
+	p.controlTable[0x24], p.controlTable[0x25] = 0x00, 0x02 // 512
+	a, err = s.Angle()
+	assert.NoError(t, err)
+	assert.InDelta(t, 512*300/1023.0-150, a.Degrees(), 1e-9)
+
+	// Nothing to move to in wheel mode.
+	p.controlTable[0x06], p.controlTable[0x07] = 0, 0
+	p.controlTable[0x08], p.controlTable[0x09] = 0, 0
+	err = s.MoveTo(0)
+	assert.EqualError(t, err, "can't move to an angle in wheel mode")
+}
@@ -2,6 +2,7 @@ package ax
 
 import (
 	"io"
+	"math"
 
 	"github.com/adammck/dynamixel/protocol/v1"
 	reg "github.com/adammck/dynamixel/registers"
@@ -14,7 +15,38 @@ func New(network io.ReadWriter, ID int) (*servo.Servo, error) {
 	return servo.New(v1.New(network), Registers, ID), nil
 }
 
+// NewAX18A returns a new AX-18A servo with the given ID. It's the same as the
+// AX-12, but faster.
+// See: http://support.robotis.com/en/product/dynamixel/ax_series/ax-18f.htm
+func NewAX18A(network io.ReadWriter, ID int) (*servo.Servo, error) {
+	return servo.New(v1.New(network), AX18A, ID), nil
+}
+
+const (
+
+	// Unit conversions. The AX has 1024 steps over 300 degrees, with 512 (150
+	// deg) at the center.
+	maxPos          = 1023
+	maxAngle        = 300.0
+	positionToAngle = maxAngle / maxPos // 0.293255132
+)
+
+// PositionToAngle converts a position (as in GoalPosition) to an angle in
+// degrees, where 0 is the center.
+func PositionToAngle(pos int) float64 {
+	return float64(pos-512) * positionToAngle
+}
+
+// AngleToPosition converts an angle in degrees, where 0 is the center, to the
+// nearest position.
+func AngleToPosition(angle float64) int {
+	return int(math.Round(angle/positionToAngle)) + 512
+}
+
+// Registers is the control table of the AX-12 (model number 12), and AX18A is
+// that of the AX-18A (18).
 var Registers reg.Map
+var AX18A reg.Map
 
 func init() {
 	x := 0
@@ -22,39 +54,44 @@ func init() {
 	Registers = reg.Map{
 
 		// EEPROM: Persisted
-		reg.ModelNumber:             {0x00, 2, reg.RO, x, x},
-		reg.FirmwareVersion:         {0x02, 1, reg.RO, x, x},
-		reg.ServoID:                 {0x03, 1, reg.RW, 0, 252}, // renamed from ID for clarity
-		reg.BaudRate:                {0x04, 1, reg.RW, 0, 254}, // bps = 2000000/(value+1)
-		reg.ReturnDelayTime:         {0x05, 1, reg.RW, 0, 254}, // usec = value*2
-		reg.CwAngleLimit:            {0x06, 2, reg.RW, 0, 1023},
-		reg.CcwAngleLimit:           {0x08, 2, reg.RW, 0, 1023},
-		reg.HighestLimitTemperature: {0x0b, 1, reg.RW, 0, 70},   // docs says not to set
-		reg.LowestLimitVoltage:      {0x0c, 1, reg.RW, 50, 250}, // volt = value*0.1
-		reg.HighestLimitVoltage:     {0x0d, 1, reg.RW, 50, 250}, // volt = value*0.1
-		reg.MaxTorque:               {0x0e, 2, reg.RW, 0, 1023}, // from zero to max torque
-		reg.StatusReturnLevel:       {0x10, 1, reg.RW, 0, 2},    // enum; see docs
-		reg.AlarmLed:                {0x11, 1, reg.RW, 0, 256},  // enum; see docs
-		reg.AlarmShutdown:           {0x12, 1, reg.RW, 0, 256},  // enum; see docs
+		reg.ModelNumber:             {0x00, 2, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.FirmwareVersion:         {0x02, 1, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ServoID:                 {0x03, 1, reg.RW, 0, 252, false, reg.None, 0, reg.EEPROM}, // renamed from ID for clarity
+		reg.BaudRate:                {0x04, 1, reg.RW, 0, 254, false, reg.None, 0, reg.EEPROM}, // bps = 2000000/(value+1)
+		reg.ReturnDelayTime:         {0x05, 1, reg.RW, 0, 254, false, reg.Microseconds, 2, reg.EEPROM},
+		reg.CwAngleLimit:            {0x06, 2, reg.RW, 0, 1023, false, reg.Degrees, positionToAngle, reg.EEPROM},
+		reg.CcwAngleLimit:           {0x08, 2, reg.RW, 0, 1023, false, reg.Degrees, positionToAngle, reg.EEPROM},
+		reg.HighestLimitTemperature: {0x0b, 1, reg.RW, 0, 70, false, reg.Celsius, 1, reg.EEPROM}, // docs says not to set
+		reg.LowestLimitVoltage:      {0x0c, 1, reg.RW, 50, 250, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.HighestLimitVoltage:     {0x0d, 1, reg.RW, 50, 250, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.MaxTorque:               {0x0e, 2, reg.RW, 0, 1023, false, reg.Percent, 100.0 / 1023, reg.EEPROM}, // from zero to max torque
+		reg.StatusReturnLevel:       {0x10, 1, reg.RW, 0, 2, false, reg.None, 0, reg.EEPROM},                  // enum; see docs
+		reg.AlarmLed:                {0x11, 1, reg.RW, 0, 127, false, reg.None, 0, reg.EEPROM},                // enum; see docs
+		reg.AlarmShutdown:           {0x12, 1, reg.RW, 0, 127, false, reg.None, 0, reg.EEPROM},                // enum; see docs
 
 		// RAM: Reset to default when power-cycled
-		reg.TorqueEnable:          {0x18, 1, reg.RW, 0, 1},    // bool
-		reg.Led:                   {0x19, 1, reg.RW, 0, 1},    // bool
-		reg.CwComplianceMargin:    {0x1a, 1, reg.RW, 0, 255},  // def=1
-		reg.CcwComplianceMargin:   {0x1b, 1, reg.RW, 0, 255},  // def=1
-		reg.CwComplianceSlope:     {0x1c, 1, reg.RW, 0, 254},  // stepped (see docs), def=32
-		reg.CcwComplianceSlope:    {0x1d, 1, reg.RW, 0, 254},  // stepped (see docs), def=32
-		reg.GoalPosition:          {0x1e, 2, reg.RW, 0, 1023}, // deg = value*0.29; 512 (150 deg) is center
-		reg.MovingSpeed:           {0x20, 2, reg.RW, 0, 1023}, // joint mode: rpm = ~value*0.111, but 0 = max rpm. wheel mode: see docs
-		reg.TorqueLimit:           {0x22, 2, reg.RW, 0, 1023}, // zero to max torque
-		reg.PresentPosition:       {0x24, 2, reg.RO, x, x},    // like goalPosition
-		reg.PresentSpeed:          {0x26, 2, reg.RO, x, x},
-		reg.PresentLoad:           {0x28, 2, reg.RO, x, x},
-		reg.PresentVoltage:        {0x2a, 1, reg.RO, x, x},
-		reg.PresentTemperature:    {0x2b, 1, reg.RO, x, x},
-		reg.RegisteredInstruction: {0x2c, 1, reg.RO, x, x},
-		reg.Moving:                {0x2e, 1, reg.RO, x, x},
-		reg.Lock:                  {0x2f, 1, reg.RW, 0, 1}, // bool
-		reg.Punch:                 {0x30, 2, reg.RW, 32, 1023},
+		reg.TorqueEnable:          {0x18, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},                     // bool
+		reg.Led:                   {0x19, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},                     // bool
+		reg.CwComplianceMargin:    {0x1a, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},                   // def=1
+		reg.CcwComplianceMargin:   {0x1b, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},                   // def=1
+		reg.CwComplianceSlope:     {0x1c, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},                   // stepped (see docs), def=32
+		reg.CcwComplianceSlope:    {0x1d, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},                   // stepped (see docs), def=32
+		reg.GoalPosition:          {0x1e, 2, reg.RW, 0, 1023, false, reg.Degrees, positionToAngle, reg.RAM}, // 512 (150 deg) is center
+		reg.MovingSpeed:           {0x20, 2, reg.RW, 0, 2047, false, reg.RPM, 0.111, reg.RAM},               // joint mode: 0 = max rpm. wheel mode: bit 10 is direction
+		reg.TorqueLimit:           {0x22, 2, reg.RW, 0, 1023, false, reg.Percent, 100.0 / 1023, reg.RAM},    // zero to max torque
+		reg.PresentPosition:       {0x24, 2, reg.RO, x, x, false, reg.Degrees, positionToAngle, reg.RAM},    // like goalPosition
+		reg.PresentSpeed:          {0x26, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM},                     // bit 10 is direction, so no unit
+		reg.PresentLoad:           {0x28, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM},                     // bit 10 is direction, so no unit
+		reg.PresentVoltage:        {0x2a, 1, reg.RO, x, x, false, reg.Volts, 0.1, reg.RAM},
+		reg.PresentTemperature:    {0x2b, 1, reg.RO, x, x, false, reg.Celsius, 1, reg.RAM},
+		reg.RegisteredInstruction: {0x2c, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.Moving:                {0x2e, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.Lock:                  {0x2f, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM}, // bool
+		reg.Punch:                 {0x30, 2, reg.RW, 32, 1023, false, reg.None, 0, reg.RAM},
 	}
+
+	AX18A = Registers.Copy()
+
+	servo.RegisterModel(&servo.Model{Name: "AX-12", Number: 12, Protocol: 1, Registers: Registers, Steps: maxPos, Range: maxAngle, Center: 512})
+	servo.RegisterModel(&servo.Model{Name: "AX-18A", Number: 18, Protocol: 1, Registers: AX18A, Steps: maxPos, Range: maxAngle, Center: 512})
 }
@@ -0,0 +1,17 @@
+package ax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversions(t *testing.T) {
+	assert.Equal(t, 0.0, PositionToAngle(512))
+	assert.InDelta(t, -150.15, PositionToAngle(0), 0.01)
+	assert.InDelta(t, 149.85, PositionToAngle(1023), 0.01)
+
+	assert.Equal(t, 512, AngleToPosition(0))
+	assert.Equal(t, 819, AngleToPosition(90))
+	assert.Equal(t, 205, AngleToPosition(-90))
+}
@@ -0,0 +1,240 @@
+package servo
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Staleness policies for cached registers. Any other duration means that a
+// cached value is read again once it's that old. See SetStaleness.
+const (
+	NeverCache   time.Duration = 0
+	CacheForever time.Duration = math.MaxInt64
+)
+
+// cache is a shadow of (part of) the control table of a servo. See SetCached.
+type cache struct {
+	entries   map[reg.RegName]*entry
+	staleness map[reg.RegName]time.Duration
+
+	// The source of time, which tests can replace.
+	now func() time.Time
+}
+
+// entry is the cached value of a single register.
+type entry struct {
+	value int
+	read  time.Time
+
+	// Whether the value has been set, but not yet written to the servo.
+	dirty bool
+}
+
+func newCache() *cache {
+	return &cache{
+		entries:   map[reg.RegName]*entry{},
+		staleness: map[reg.RegName]time.Duration{},
+		now:       time.Now,
+	}
+}
+
+// SetCached enables (or disables) the register cache. When enabled, the value
+// of each register is cached after it's first read or written, so reading it
+// again doesn't need to talk to the servo. Writes to cached RAM registers (e.g.
+// GoalPosition) are not sent to the servo until Flush is called, so that only
+// the registers which have actually changed are written.
+//
+// By default, read-only RAM registers (e.g. PresentPosition) and TorqueEnable,
+// which the servo can change by itself, are never cached. Everything else is
+// cached forever. See SetStaleness to change that.
+//
+// Disabling the cache discards it, including any writes which haven't been
+// flushed.
+func (s *Servo) SetCached(cached bool) {
+	if !cached {
+		s.cache = nil
+		return
+	}
+
+	if s.cache == nil {
+		s.cache = newCache()
+	}
+}
+
+// SetStaleness sets how long the cached value of the given register can be
+// used for, before it's read from the servo again. Use NeverCache to always
+// read it, or CacheForever to only read it once. The cache must be enabled.
+func (s *Servo) SetStaleness(n reg.RegName, d time.Duration) {
+	if s.cache == nil {
+		return
+	}
+
+	s.cache.staleness[n] = d
+	if d == NeverCache {
+		if e, ok := s.cache.entries[n]; ok && !e.dirty {
+			delete(s.cache.entries, n)
+		}
+	}
+}
+
+// Invalidate discards the cached values of the given registers (or of every
+// register, if none are given), so they will be read from the servo next time.
+// Writes which haven't been flushed are discarded too.
+func (s *Servo) Invalidate(names ...reg.RegName) {
+	if s.cache == nil {
+		return
+	}
+
+	if len(names) == 0 {
+		s.cache.entries = map[reg.RegName]*entry{}
+		return
+	}
+
+	for _, n := range names {
+		delete(s.cache.entries, n)
+	}
+}
+
+// Dirty returns the registers which have been set, but not yet flushed, in
+// address order.
+func (s *Servo) Dirty() []reg.RegName {
+	if s.cache == nil {
+		return nil
+	}
+
+	var out []reg.RegName
+	for n, e := range s.cache.entries {
+		if e.dirty {
+			out = append(out, n)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return s.registers[out[i]].Address < s.registers[out[j]].Address
+	})
+
+	return out
+}
+
+// Flush writes the registers which have been set since the last flush, if the
+// cache is enabled. Adjacent registers are written together, so setting (for
+// example) GoalPosition and MovingSpeed of an AX-12 sends one instruction. The
+// writes are buffered if the servo is (see SetBuffered), in which case they're
+// dropped from the cache rather than kept, since they might never take effect.
+func (s *Servo) Flush() error {
+	dirty := s.Dirty()
+	if len(dirty) == 0 {
+		return nil
+	}
+
+	rl, err := s.ReturnLevel()
+	if err != nil {
+		return err
+	}
+
+	for len(dirty) > 0 {
+
+		// Find the run of registers which are contiguous in the control table.
+		var params []byte
+		addr := s.registers[dirty[0]].Address
+		i := 0
+		for ; i < len(dirty); i++ {
+			r := s.registers[dirty[i]]
+			if r.Address != addr+len(params) {
+				break
+			}
+
+			b, err := r.Encode(s.cache.entries[dirty[i]].value)
+			if err != nil {
+				return err
+			}
+
+			params = append(params, b...)
+		}
+
+		if s.buffered {
+			err = s.Protocol.RegWrite(s.ID, addr, params, rl == 2)
+		} else {
+			err = s.Protocol.WriteData(s.ID, addr, params, rl == 2)
+		}
+		if err != nil {
+			return err
+		}
+
+		// Buffered writes don't take effect until ACTION, which might never be
+		// sent, so the values aren't known to be on the servo. (See setRegister.)
+		if s.buffered {
+			s.Invalidate(dirty[:i]...)
+		} else {
+			for _, n := range dirty[:i] {
+				s.cache.entries[n].dirty = false
+			}
+		}
+
+		dirty = dirty[i:]
+	}
+
+	return nil
+}
+
+// maxAge returns how long the cached value of the given register is valid.
+func (c *cache) maxAge(n reg.RegName, r *reg.Register) time.Duration {
+	if d, ok := c.staleness[n]; ok {
+		return d
+	}
+
+	if n == reg.TorqueEnable || (r.Area == reg.RAM && r.Access == reg.RO) {
+		return NeverCache
+	}
+
+	return CacheForever
+}
+
+// get returns the cached value of the given register, if it's fresh.
+func (c *cache) get(n reg.RegName, r *reg.Register) (int, bool) {
+	e, ok := c.entries[n]
+	if !ok {
+		return 0, false
+	}
+
+	if e.dirty {
+		return e.value, true
+	}
+
+	d := c.maxAge(n, r)
+	if d == CacheForever || c.now().Sub(e.read) < d {
+		return e.value, true
+	}
+
+	return 0, false
+}
+
+// set records the value of the given register, as read from (or written to)
+// the servo, if it's cached at all.
+func (c *cache) set(n reg.RegName, r *reg.Register, v int) {
+	if c.maxAge(n, r) == NeverCache {
+		return
+	}
+
+	c.entries[n] = &entry{value: v, read: c.now()}
+}
+
+// setDirty records a write to the given register, to be sent by Flush. Returns
+// false if the register must be written now: because it's not cached, or is in
+// EEPROM, or is TorqueEnable (which torque-safe writes rely on).
+func (c *cache) setDirty(n reg.RegName, r *reg.Register, v int) bool {
+	if r.Area != reg.RAM || n == reg.TorqueEnable || c.maxAge(n, r) == NeverCache {
+		return false
+	}
+
+	// The servo already has this value, so there's nothing to flush.
+	if cur, ok := c.get(n, r); ok && cur == v {
+		return true
+	}
+
+	c.entries[n] = &entry{value: v, read: c.now(), dirty: true}
+	return true
+}
@@ -0,0 +1,120 @@
+package servo
+
+import (
+	"testing"
+	"time"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	m := reg.Map{
+		reg.CwAngleLimit:    {Address: 6, Length: 2, Access: reg.RW, Max: 1023, Area: reg.EEPROM},
+		reg.TorqueEnable:    {Address: 24, Length: 1, Access: reg.RW, Max: 1},
+		reg.GoalPosition:    {Address: 30, Length: 2, Access: reg.RW, Max: 1023},
+		reg.MovingSpeed:     {Address: 32, Length: 2, Access: reg.RW, Max: 1023},
+		reg.PresentPosition: {Address: 36, Length: 2, Access: reg.RO},
+	}
+
+	p, s := servo(m, map[int]byte{6: 0x10, 36: 0x20})
+	s.SetCached(true)
+
+	now := time.Unix(0, 0)
+	s.cache.now = func() time.Time { return now }
+
+	// EEPROM is only read once
+	v, err := s.CWAngleLimit()
+	assert.NoError(t, err)
+	assert.Equal(t, 0x10, v)
+	p.controlTable[6] = 0x11
+	v, _ = s.CWAngleLimit()
+	assert.Equal(t, 0x10, v)
+	assert.Equal(t, 1, p.reads)
+
+	// read-only RAM is never cached
+	s.PresentPosition()
+	s.PresentPosition()
+	assert.Equal(t, 3, p.reads)
+
+	// RAM writes are deferred until flushed
+	err = s.SetGoalPosition(512)
+	assert.NoError(t, err)
+	err = s.SetMovingSpeed(100)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0}, p.controlTable[30:34])
+	assert.Equal(t, []reg.RegName{reg.GoalPosition, reg.MovingSpeed}, s.Dirty())
+
+	v, err = s.GoalPosition()
+	assert.NoError(t, err)
+	assert.Equal(t, 512, v)
+	assert.Equal(t, 3, p.reads)
+
+	// but torque is always written now
+	err = s.SetTorqueEnable(true)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(1), p.controlTable[24])
+
+	err = s.Flush()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x02, 0x64, 0x00}, p.controlTable[30:34])
+	assert.Empty(t, s.Dirty())
+
+	// setting the same value again doesn't dirty it
+	s.SetGoalPosition(512)
+	assert.Empty(t, s.Dirty())
+
+	// stale values are read again
+	s.SetStaleness(reg.CwAngleLimit, time.Second)
+	now = now.Add(2 * time.Second)
+	v, _ = s.CWAngleLimit()
+	assert.Equal(t, 0x11, v)
+	assert.Equal(t, 4, p.reads)
+	v, _ = s.CWAngleLimit()
+	assert.Equal(t, 4, p.reads)
+
+	s.Invalidate()
+	v, _ = s.CWAngleLimit()
+	assert.Equal(t, 5, p.reads)
+
+	// buffered writes may never be applied, so they're read again
+	s.SetBuffered(true)
+	err = s.SetGoalPosition(300)
+	assert.NoError(t, err)
+	s.SetBuffered(false)
+	p.Action()
+	v, _ = s.GoalPosition()
+	assert.Equal(t, 300, v)
+	assert.Equal(t, 6, p.reads)
+
+	// setting the return level updates the cache
+	v, _ = s.getRegister(reg.StatusReturnLevel)
+	assert.Equal(t, 2, v)
+	err = s.SetReturnLevel(1)
+	assert.NoError(t, err)
+	v, _ = s.getRegister(reg.StatusReturnLevel)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 7, p.reads)
+	s.SetReturnLevel(2)
+
+	// nor are buffered flushes, so the same value is written again later
+	s.SetGoalPosition(400)
+	s.SetBuffered(true)
+	err = s.Flush()
+	assert.NoError(t, err)
+	s.SetBuffered(false)
+	assert.Len(t, p.writeBuf, 1)
+	p.writeBuf = nil // ACTION is never sent
+	s.SetGoalPosition(400)
+	assert.Equal(t, []reg.RegName{reg.GoalPosition}, s.Dirty())
+	err = s.Flush()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x90, 0x01}, p.controlTable[30:32])
+
+	// disabling the cache discards it
+	s.SetGoalPosition(100)
+	s.SetCached(false)
+	assert.Empty(t, s.Dirty())
+	assert.NoError(t, s.Flush())
+	assert.Equal(t, []byte{0x90, 0x01}, p.controlTable[30:32])
+}
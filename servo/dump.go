@@ -0,0 +1,333 @@
+package servo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"go.yaml.in/yaml/v3"
+)
+
+// Dump is the contents of the control table of a servo, as returned by
+// Servo.Dump. It can be serialized to JSON or YAML, to be restored to another
+// servo (e.g. when replacing a broken one) by Servo.Restore.
+type Dump struct {
+
+	// The name of the model, if it's known.
+	Model string `json:"model,omitempty" yaml:"model,omitempty"`
+
+	// The value of each register, by RegName (e.g. "GoalPosition").
+	Registers map[string]int `json:"registers" yaml:"registers"`
+}
+
+// RestoreOptions control which registers Servo.Restore writes.
+type RestoreOptions struct {
+
+	// Don't change the ID or baud rate of the servo. Cloning these is almost
+	// never what you want while the old servo is still on the bus.
+	SkipID       bool
+	SkipBaudRate bool
+
+	// Also restore the volatile state of the servo (see volatile): its goals,
+	// speed, LEDs, and whether torque is enabled. This is off by default,
+	// because it makes the new servo move to wherever the old one was going.
+	RestoreState bool
+}
+
+// volatile is the RAM registers which hold the current state of a servo,
+// rather than its configuration. Restore skips these by default.
+var volatile = map[reg.RegName]bool{
+	reg.TorqueEnable:     true,
+	reg.Led:              true,
+	reg.LedRed:           true,
+	reg.LedGreen:         true,
+	reg.LedBlue:          true,
+	reg.GoalPosition:     true,
+	reg.MovingSpeed:      true,
+	reg.GoalVelocity:     true,
+	reg.GoalTorque:       true,
+	reg.GoalCurrent:      true,
+	reg.GoalPWM:          true,
+	reg.GoalAcceleration: true,
+	reg.Lock:             true,
+}
+
+// Change is a register which Restore would change, or did.
+type Change struct {
+	Name reg.RegName
+	Old  int
+	New  int
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%v: %d -> %d", c.Name, c.Old, c.New)
+}
+
+// Dump reads every register of the servo, both EEPROM and RAM.
+func (s *Servo) Dump() (*Dump, error) {
+	names := make([]reg.RegName, 0, len(s.registers))
+	for n := range s.registers {
+		names = append(names, n)
+	}
+
+	ss, err := s.Snapshot(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Dump{Registers: make(map[string]int, len(ss.Values))}
+	if s.model != nil {
+		d.Model = s.model.Name
+	}
+
+	for n, v := range ss.Values {
+		d.Registers[n.String()] = v
+	}
+
+	return d, nil
+}
+
+// JSON returns the dump as indented JSON.
+func (d *Dump) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// YAML returns the dump as YAML.
+func (d *Dump) YAML() ([]byte, error) {
+	return yaml.Marshal(d)
+}
+
+// ParseDumpJSON parses a dump from JSON. Unknown fields are an error.
+func ParseDumpJSON(b []byte) (*Dump, error) {
+	d := &Dump{}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(d)
+	if err != nil {
+		return nil, fmt.Errorf("parsing json: %s", err)
+	}
+
+	return d, nil
+}
+
+// ParseDumpYAML parses a dump from YAML. Unknown fields are an error.
+func ParseDumpYAML(b []byte) (*Dump, error) {
+	d := &Dump{}
+
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	err := dec.Decode(d)
+	if err != nil {
+		return nil, fmt.Errorf("parsing yaml: %s", err)
+	}
+
+	return d, nil
+}
+
+// Diff returns the registers which Restore would change, without changing
+// them. Read-only registers are skipped, as are volatile RAM registers unless
+// opts.RestoreState is set. Returns an error if the dump is of a different
+// model, or contains a register which this servo doesn't have (or a value which
+// is out of range).
+func (s *Servo) Diff(d *Dump, opts RestoreOptions) ([]Change, error) {
+	if d.Model != "" && s.model != nil && d.Model != s.model.Name {
+		return nil, fmt.Errorf("can't restore %s dump to %s", d.Model, s.model.Name)
+	}
+
+	values := map[reg.RegName]int{}
+	for name, v := range d.Registers {
+		n, ok := reg.ByName(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown register: %s", name)
+		}
+
+		r, ok := s.registers[n]
+		if !ok {
+			return nil, fmt.Errorf("can't restore unsupported register: %v", n)
+		}
+
+		if r.Access == reg.RO {
+			continue
+		}
+
+		if (n == reg.ServoID && opts.SkipID) || (n == reg.BaudRate && opts.SkipBaudRate) {
+			continue
+		}
+
+		if r.Area == reg.RAM && volatile[n] && !opts.RestoreState {
+			continue
+		}
+
+		if v < r.Min || v > r.Max {
+			return nil, fmt.Errorf("value of %v out of range: %d (min=%d, max=%d)", n, v, r.Min, r.Max)
+		}
+
+		values[n] = v
+	}
+
+	names := make([]reg.RegName, 0, len(values))
+	for n := range values {
+		names = append(names, n)
+	}
+
+	ss, err := s.Snapshot(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []Change
+	for _, n := range names {
+		if ss.Values[n] != values[n] {
+			out = append(out, Change{n, ss.Values[n], values[n]})
+		}
+	}
+
+	// EEPROM first, then RAM, in address order. But enable the torque (and
+	// change the return level, which changes how the servo responds) last. And
+	// change the baud rate after that, since the servo can't be reached at the
+	// old one afterwards.
+	last := func(n reg.RegName) int {
+		switch n {
+		case reg.BaudRate:
+			return 2
+		case reg.TorqueEnable, reg.StatusReturnLevel:
+			return 1
+		}
+
+		return 0
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := s.registers[out[i].Name], s.registers[out[j].Name]
+		if last(out[i].Name) != last(out[j].Name) {
+			return last(out[i].Name) < last(out[j].Name)
+		}
+
+		if a.Area != b.Area {
+			return a.Area == reg.EEPROM
+		}
+
+		return a.Address < b.Address
+	})
+
+	return out, nil
+}
+
+// Restore writes the registers in the given dump to the servo, and returns the
+// ones which were changed. Registers which already have the right value aren't
+// written, to spare the EEPROM. If torque-safe writes are enabled (see
+// SetTorqueSafe), torque is disabled once while all the EEPROM registers are
+// written. See Diff to preview the changes.
+//
+// The baud rate is changed last, after which the servo must be reached via a
+// network at the new rate. If torque-safe writes are enabled, torque is
+// disabled to change it, and left disabled, since it can't be re-enabled at
+// the old rate.
+func (s *Servo) Restore(d *Dump, opts RestoreOptions) ([]Change, error) {
+	changes, err := s.Diff(d, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range changes {
+		s.Invalidate(c.Name)
+	}
+
+	rl, err := s.ReturnLevel()
+	if err != nil {
+		return nil, err
+	}
+
+	write := func(c Change) error {
+		r := s.registers[c.Name]
+		params, err := r.Encode(c.New)
+		if err != nil {
+			return err
+		}
+
+		err = s.Protocol.WriteData(s.ID, r.Address, params, rl == 2)
+		if err != nil {
+			return err
+		}
+
+		if r.Area == reg.EEPROM {
+			s.eepromWrites++
+		}
+
+		if c.Name == reg.ServoID {
+			s.ID = c.New
+		}
+
+		return nil
+	}
+
+	var done []Change
+	err = s.torqueSafely(func() error {
+		for _, c := range changes {
+			if s.registers[c.Name].Area != reg.EEPROM || c.Name == reg.StatusReturnLevel || c.Name == reg.BaudRate {
+				continue
+			}
+
+			err := write(c)
+			if err != nil {
+				return err
+			}
+
+			done = append(done, c)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return done, err
+	}
+
+	for _, c := range changes {
+		if s.registers[c.Name].Area == reg.EEPROM && c.Name != reg.StatusReturnLevel && c.Name != reg.BaudRate {
+			continue
+		}
+
+		switch c.Name {
+		case reg.StatusReturnLevel:
+			err = s.SetReturnLevel(c.New)
+
+		case reg.BaudRate:
+			err = s.writeBaudRate(func() error { return write(c) })
+
+		default:
+			err = write(c)
+		}
+
+		if err != nil {
+			return done, err
+		}
+
+		done = append(done, c)
+	}
+
+	return done, nil
+}
+
+// writeBaudRate calls write, with torque disabled first if torque-safe writes
+// are enabled. Unlike torqueSafely, torque isn't re-enabled afterwards, since
+// the servo is no longer listening at the old baud rate.
+func (s *Servo) writeBaudRate(write func() error) error {
+	if _, ok := s.registers[reg.TorqueEnable]; ok && s.torqueSafe {
+		torque, err := s.TorqueEnable()
+		if err != nil {
+			return err
+		}
+
+		if torque {
+			err = s.SetTorqueEnable(false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return write()
+}
@@ -0,0 +1,132 @@
+package servo
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDumpRestore(t *testing.T) {
+	m := reg.Map{
+		reg.ModelNumber:  {Address: 0, Length: 2, Access: reg.RO},
+		reg.BaudRate:     {Address: 4, Length: 1, Access: reg.RW, Max: 254, Area: reg.EEPROM},
+		reg.CwAngleLimit: {Address: 6, Length: 2, Access: reg.RW, Max: 1023, Area: reg.EEPROM},
+		reg.TorqueEnable: {Address: 24, Length: 1, Access: reg.RW, Max: 1},
+		reg.GoalPosition: {Address: 30, Length: 2, Access: reg.RW, Max: 1023},
+		reg.TorqueLimit:  {Address: 34, Length: 2, Access: reg.RW, Max: 1023},
+	}
+
+	_, src := servo(m, map[int]byte{0: 12, 4: 1, 6: 10, 24: 1, 30: 0x00, 31: 0x02, 34: 0x00, 35: 0x02})
+
+	d, err := src.Dump()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{
+		"ModelNumber":       12,
+		"BaudRate":          1,
+		"CwAngleLimit":      10,
+		"TorqueEnable":      1,
+		"GoalPosition":      512,
+		"TorqueLimit":       512,
+		"ServoID":           1,
+		"StatusReturnLevel": 2,
+	}, d.Registers)
+
+	// round trip via both formats
+	b, err := d.JSON()
+	assert.NoError(t, err)
+	d2, err := ParseDumpJSON(b)
+	assert.NoError(t, err)
+	assert.Equal(t, d, d2)
+
+	b, err = d.YAML()
+	assert.NoError(t, err)
+	d2, err = ParseDumpYAML(b)
+	assert.NoError(t, err)
+	assert.Equal(t, d, d2)
+
+	_, err = ParseDumpYAML([]byte("registers: {}\nfoo: 1\n"))
+	assert.Error(t, err)
+
+	// the new servo has a different ID and baud rate, and a read-only register
+	// which can't be changed
+	p, dst := servo(m, map[int]byte{0: 13, 4: 34, 40: 2})
+	dst.ID = 2
+
+	// by default, the volatile state (the goal and torque) isn't restored, and
+	// the baud rate is changed last
+	changes, err := dst.Diff(d, RestoreOptions{SkipID: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{reg.CwAngleLimit, 0, 10},
+		{reg.TorqueLimit, 0, 512},
+		{reg.BaudRate, 34, 1},
+	}, changes)
+	assert.Equal(t, "CwAngleLimit: 0 -> 10", changes[0].String())
+
+	changes, err = dst.Diff(d, RestoreOptions{SkipID: true, RestoreState: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{reg.CwAngleLimit, 0, 10},
+		{reg.GoalPosition, 0, 512},
+		{reg.TorqueLimit, 0, 512},
+		{reg.TorqueEnable, 0, 1},
+		{reg.BaudRate, 34, 1},
+	}, changes)
+
+	// diff doesn't write anything
+	assert.Empty(t, p.writes)
+
+	changes, err = dst.Restore(d, RestoreOptions{SkipID: true, SkipBaudRate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{reg.CwAngleLimit, 0, 10},
+		{reg.TorqueLimit, 0, 512},
+	}, changes)
+	assert.Equal(t, byte(34), p.controlTable[4])
+	assert.Equal(t, byte(2), p.controlTable[40])
+	assert.Equal(t, []byte{0x00, 0x00}, p.controlTable[30:32])
+	assert.Equal(t, byte(0), p.controlTable[24])
+	assert.Equal(t, 1, dst.EEPROMWrites())
+
+	// nothing left to do
+	changes, err = dst.Restore(d, RestoreOptions{SkipID: true, SkipBaudRate: true})
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// now clone the rest, including the state. the baud rate is written after
+	// everything else, with torque disabled (and left that way) since it's
+	// torque-safe.
+	dst.SetTorqueSafe(true)
+	p.writes = nil
+	changes, err = dst.Restore(d, RestoreOptions{RestoreState: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{reg.ServoID, 2, 1},
+		{reg.GoalPosition, 0, 512},
+		{reg.TorqueEnable, 0, 1},
+		{reg.BaudRate, 34, 1},
+	}, changes)
+	assert.Equal(t, []writeEvent{
+		{40, []byte{1}},
+		{30, []byte{0x00, 0x02}},
+		{24, []byte{1}},
+		{24, []byte{0}},
+		{4, []byte{1}},
+	}, p.writes)
+	assert.Equal(t, byte(1), p.controlTable[4])
+	assert.Equal(t, byte(1), p.controlTable[40])
+	assert.Equal(t, byte(0), p.controlTable[24])
+	assert.Equal(t, 1, dst.ID)
+	assert.Equal(t, 3, dst.EEPROMWrites())
+
+	// registers which the servo doesn't have
+	d.Registers["GoalVelocity"] = 1
+	_, err = dst.Diff(d, RestoreOptions{})
+	assert.Error(t, err)
+
+	// different models
+	dst.model = &Model{Name: "AX-12"}
+	_, err = dst.Diff(&Dump{Model: "XL-320"}, RestoreOptions{})
+	assert.EqualError(t, err, "can't restore XL-320 dump to AX-12")
+}
@@ -0,0 +1,66 @@
+// Package ex provides the control table of the EX-106+. It's mostly like the
+// AX-12, but has 4096 steps over 250.92 degrees, senses current, and can be
+// paired with another EX-106+ as master and slave.
+//
+// See: http://support.robotis.com/en/product/dynamixel/ex_series/ex-106.htm
+package ex
+
+import (
+	"io"
+	"math"
+
+	"github.com/adammck/dynamixel/protocol/v1"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/ax"
+)
+
+const (
+
+	// Unit conversions. 2048 (125.49 deg) is the center.
+	maxPos          = 4095
+	maxAngle        = 250.92
+	positionToAngle = maxAngle / maxPos // 0.06127
+)
+
+// EX106P is the control table of the EX-106+ (model number 107).
+var EX106P reg.Map
+
+// New returns a new EX-106+ servo with the given ID.
+func New(network io.ReadWriter, ID int) (*servo.Servo, error) {
+	return servo.New(v1.New(network), EX106P, ID), nil
+}
+
+// PositionToAngle converts a position (as in GoalPosition) to an angle in
+// degrees, where 0 is the center.
+func PositionToAngle(pos int) float64 {
+	return float64(pos-2048) * positionToAngle
+}
+
+// AngleToPosition converts an angle in degrees, where 0 is the center, to the
+// nearest position.
+func AngleToPosition(angle float64) int {
+	return int(math.Round(angle/positionToAngle)) + 2048
+}
+
+func init() {
+	x := 0
+
+	m := ax.Registers.Copy()
+	m[reg.CwAngleLimit].Max = maxPos
+	m[reg.CcwAngleLimit].Max = maxPos
+	m[reg.GoalPosition].Max = maxPos
+	m[reg.HighestLimitTemperature].Max = 150 // docs says not to set
+	m[reg.Punch].Min = 0
+
+	for _, n := range []reg.RegName{reg.CwAngleLimit, reg.CcwAngleLimit, reg.GoalPosition, reg.PresentPosition} {
+		m[n].Scale = positionToAngle
+	}
+
+	m[reg.DriveMode] = &reg.Register{0x0a, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM}  // bit 0 = slave, bit 1 = reverse
+	m[reg.SensedCurrent] = &reg.Register{0x38, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM} // amps = (value-512)*0.01
+
+	EX106P = m
+
+	servo.RegisterModel(&servo.Model{Name: "EX-106+", Number: 107, Protocol: 1, Registers: EX106P, Steps: maxPos, Range: maxAngle, Center: 2048})
+}
@@ -0,0 +1,28 @@
+package ex
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisters(t *testing.T) {
+	assert.Equal(t, 4095, EX106P[reg.GoalPosition].Max)
+	assert.Contains(t, EX106P, reg.SensedCurrent)
+	assert.Contains(t, EX106P, reg.DriveMode)
+
+	// The AX-12 table which it's copied from must not have been modified.
+	assert.Equal(t, 1023, ax.Registers[reg.GoalPosition].Max)
+	assert.NotContains(t, ax.Registers, reg.SensedCurrent)
+}
+
+func TestConversions(t *testing.T) {
+	assert.Equal(t, 0.0, PositionToAngle(2048))
+	assert.InDelta(t, -125.49, PositionToAngle(0), 0.01)
+	assert.InDelta(t, 125.43, PositionToAngle(4095), 0.01)
+
+	assert.Equal(t, 2048, AngleToPosition(0))
+	assert.Equal(t, 3517, AngleToPosition(90))
+}
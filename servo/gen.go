@@ -0,0 +1,340 @@
+//go:build ignore
+
+// This program generates the register accessors of Servo (servo_accessors.go),
+// and the typed wrappers in each model package (e.g. ax/ax_accessors.go), from
+// the registered models. Run it via go generate, after adding or changing a
+// register or model.
+//
+// It imports the model packages, which import this one, so the existing
+// generated code must compile. If it doesn't, check it out from git first.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/adammck/dynamixel/servo/ex"
+	"github.com/adammck/dynamixel/servo/mx"
+	"github.com/adammck/dynamixel/servo/pro"
+	"github.com/adammck/dynamixel/servo/rx"
+	"github.com/adammck/dynamixel/servo/x"
+	"github.com/adammck/dynamixel/servo/xl"
+)
+
+// Registers which are flags, and so are read and written as bools.
+var bools = map[reg.RegName]bool{
+	reg.TorqueEnable:            true,
+	reg.Led:                     true,
+	reg.RegisteredInstruction:   true,
+	reg.Moving:                  true,
+	reg.Lock:                    true,
+	reg.TorqueControlModeEnable: true,
+}
+
+// Registers which are sets of alarm flags. Their bits vary between models, so
+// are translated via the model's AlarmBits.
+var alarms = map[reg.RegName]bool{
+	reg.AlarmLed:            true,
+	reg.AlarmShutdown:       true,
+	reg.HardwareErrorStatus: true,
+}
+
+// Registers which are read and written as enums. The types are in modes.go.
+var enums = map[reg.RegName]string{
+	reg.ControlMode:   "ControlMode",
+	reg.OperatingMode: "OperatingMode",
+}
+
+// Registers which have hand-written accessors, and so are skipped.
+var skip = map[reg.RegName]bool{
+	reg.StatusReturnLevel: true,
+}
+
+// Doc comments of accessors which need more than the default.
+var docs = map[string]string{
+	"SetServoID": "// SetServoID changes the identity of the servo.\n" +
+		"// This is stored in EEPROM, so will persist between reboots.",
+
+	"SetGoalPosition": "// SetGoalPosition sets the goal position.\n" +
+		"//\n" +
+		"// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit\n" +
+		"//       is zero).\n" +
+		"//",
+
+	"MovingSpeed": "// MovingSpeed returns the current moving speed. This is not the speed at which\n" +
+		"// the motor is moving, it's the speed at which the servo wants to move.",
+
+	"SetMovingSpeed": "// SetMovingSpeed the moving speed.\n" +
+		"//\n" +
+		"// Note: Setting the moving speed appears to reset the TorqueEnabled register to\n" +
+		"//       true, at least on my AX12s.\n" +
+		"//",
+}
+
+// wrapper is a typed wrapper around Servo, with accessors for only the
+// registers of some models. All of the models must have the same registers.
+type wrapper struct {
+	name string // the type, e.g. XM430Servo
+	wrap string // the constructor, e.g. WrapXM430
+	desc string // e.g. "an XM430 or XH430"
+	maps []reg.Map
+}
+
+type pkg struct {
+	name     string
+	wrappers []wrapper
+}
+
+var pkgs = []pkg{
+	{"ax", []wrapper{
+		{"Servo", "Wrap", "an AX-12 or AX-18A", []reg.Map{ax.Registers, ax.AX18A}},
+	}},
+	{"xl", []wrapper{
+		{"Servo", "Wrap", "an XL-320", []reg.Map{xl.Registers}},
+	}},
+	{"rx", []wrapper{
+		{"Servo", "Wrap", "an RX-series", []reg.Map{rx.RX24F, rx.RX28, rx.RX64}},
+	}},
+	{"ex", []wrapper{
+		{"Servo", "Wrap", "an EX-106+", []reg.Map{ex.EX106P}},
+	}},
+	{"x", []wrapper{
+		{"XL430Servo", "WrapXL430", "an XL430 or XC430", []reg.Map{x.XL430W250, x.XC430W150, x.XC430W240}},
+		{"XM430Servo", "WrapXM430", "an XM430 or XH430", []reg.Map{x.XM430W210, x.XM430W350, x.XH430W210, x.XH430W350, x.XH430V210, x.XH430V350}},
+		{"XM540Servo", "WrapXM540", "an XM540", []reg.Map{x.XM540W150, x.XM540W270}},
+	}},
+	{"mx", []wrapper{
+		{"MX28Servo", "WrapMX28", "an MX-28 (protocol 1)", []reg.Map{mx.MX28}},
+		{"MX64Servo", "WrapMX64", "an MX-64 (protocol 1)", []reg.Map{mx.MX64}},
+		{"MX106Servo", "WrapMX106", "an MX-106 (protocol 1)", []reg.Map{mx.MX106}},
+		{"MX28V2Servo", "WrapMX28V2", "an MX-28 (protocol 2)", []reg.Map{mx.MX28V2}},
+		{"MX64V2Servo", "WrapMX64V2", "an MX-64 or MX-106 (protocol 2)", []reg.Map{mx.MX64V2, mx.MX106V2}},
+	}},
+	{"pro", []wrapper{
+		{"PROServo", "WrapPRO", "a PRO-series", []reg.Map{pro.H54200S500R, pro.H54100S500R, pro.H4220S300R, pro.M5460S250R, pro.M5440S250R, pro.M4210S260R}},
+		{"PServo", "WrapP", "a P-series", []reg.Map{pro.PH54200S500R, pro.PH54100S500R, pro.PH42020S300R, pro.PM54060S250R, pro.PM54040S250R, pro.PM42010S260R}},
+	}},
+}
+
+func main() {
+	write("servo_accessors.go", accessors())
+
+	for _, p := range pkgs {
+		b, err := wrappers(p)
+		if err != nil {
+			log.Fatalf("%s: %s", p.name, err)
+		}
+
+		write(fmt.Sprintf("%s/%s_accessors.go", p.name, p.name), b)
+	}
+}
+
+// accessors returns the accessors of Servo: a getter for every register of any
+// registered model, and a setter for those which are RW in any model.
+func accessors() []byte {
+	rw := map[reg.RegName]bool{}
+	for _, m := range servo.Models() {
+		for n, r := range m.Registers {
+			if _, ok := rw[n]; !ok || r.Access == reg.RW {
+				rw[n] = r.Access == reg.RW
+			}
+		}
+	}
+
+	b := &bytes.Buffer{}
+	header(b, "servo")
+	fmt.Fprintf(b, "import (\n")
+	fmt.Fprintf(b, "reg %q\n", "github.com/adammck/dynamixel/registers")
+	fmt.Fprintf(b, "%q\n", "github.com/adammck/dynamixel/utils")
+	fmt.Fprintf(b, ")\n\n")
+
+	for _, n := range sorted(rw) {
+		if skip[n] {
+			continue
+		}
+
+		name, typ := method(n), typeOf(n, "")
+		get, set := "v", "v"
+		if bools[n] {
+			get, set = "utils.IntToBool(v)", "utils.BoolToInt(v)"
+		} else if alarms[n] {
+			get = "s.alarmBits().Decode(v)"
+		} else if _, ok := enums[n]; ok {
+			get, set = fmt.Sprintf("%s(v)", typ), "int(v)"
+		}
+
+		doc(b, name, "returns the value of the %s register.", n)
+		fmt.Fprintf(b, "func (s *Servo) %s() (%s, error) {\n", name, typ)
+		if get == "v" {
+			fmt.Fprintf(b, "return s.getRegister(reg.%s)\n", n)
+		} else {
+			fmt.Fprintf(b, "v, err := s.getRegister(reg.%s)\n", n)
+			fmt.Fprintf(b, "return %s, err\n", get)
+		}
+		fmt.Fprintf(b, "}\n\n")
+
+		if rw[n] {
+			doc(b, "Set"+name, "sets the value of the %s register.", n)
+			if alarms[n] {
+				fmt.Fprintf(b, "func (s *Servo) Set%s(f %s) error {\n", name, typ)
+				fmt.Fprintf(b, "v, err := s.alarmBits().Encode(f)\n")
+				fmt.Fprintf(b, "if err != nil {\nreturn err\n}\n\n")
+			} else {
+				fmt.Fprintf(b, "func (s *Servo) Set%s(v %s) error {\n", name, typ)
+			}
+			fmt.Fprintf(b, "return s.setRegister(reg.%s, %s)\n", n, set)
+			fmt.Fprintf(b, "}\n\n")
+		}
+	}
+
+	return b.Bytes()
+}
+
+// wrappers returns the typed wrappers of the given package.
+func wrappers(p pkg) ([]byte, error) {
+	b := &bytes.Buffer{}
+	header(b, p.name)
+	fmt.Fprintf(b, "import (\n")
+	fmt.Fprintf(b, "reg %q\n", "github.com/adammck/dynamixel/registers")
+	fmt.Fprintf(b, "%q\n", "github.com/adammck/dynamixel/servo")
+	fmt.Fprintf(b, ")\n\n")
+
+	for _, w := range p.wrappers {
+		m := w.maps[0]
+		for _, o := range w.maps[1:] {
+			if !same(m, o) {
+				return nil, fmt.Errorf("the models of %s have different registers", w.name)
+			}
+		}
+
+		fmt.Fprintf(b, "// %s wraps %s servo, with accessors for only the registers which\n", w.name, w.desc)
+		fmt.Fprintf(b, "// it has, so that using any other register is a compile error.\n")
+		fmt.Fprintf(b, "type %s struct {\ns *servo.Servo\n}\n\n", w.name)
+
+		fmt.Fprintf(b, "// %s returns a %s wrapping the given servo.\n", w.wrap, w.name)
+		fmt.Fprintf(b, "func %s(s *servo.Servo) *%s {\nreturn &%s{s}\n}\n\n", w.wrap, w.name, w.name)
+
+		fmt.Fprintf(b, "// Servo returns the wrapped servo.\n")
+		fmt.Fprintf(b, "func (w *%s) Servo() *servo.Servo {\nreturn w.s\n}\n\n", w.name)
+
+		rw := map[reg.RegName]bool{}
+		for n, r := range m {
+			rw[n] = r.Access == reg.RW
+		}
+
+		for _, n := range sorted(rw) {
+			if skip[n] {
+				continue
+			}
+
+			name, typ := method(n), typeOf(n, "servo.")
+
+			doc(b, name, "returns the value of the %s register.", n)
+			fmt.Fprintf(b, "func (w *%s) %s() (%s, error) {\nreturn w.s.%s()\n}\n\n", w.name, name, typ, name)
+
+			if rw[n] {
+				doc(b, "Set"+name, "sets the value of the %s register.", n)
+				fmt.Fprintf(b, "func (w *%s) Set%s(v %s) error {\nreturn w.s.Set%s(v)\n}\n\n", w.name, name, typ, name)
+			}
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+func header(b *bytes.Buffer, pkg string) {
+	fmt.Fprintf(b, "// Code generated by gen.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(b, "package %s\n\n", pkg)
+}
+
+func doc(b *bytes.Buffer, name string, format string, n reg.RegName) {
+	if d, ok := docs[name]; ok {
+		fmt.Fprintf(b, "%s\n", d)
+		return
+	}
+
+	fmt.Fprintf(b, "// %s %s\n", name, fmt.Sprintf(format, n))
+}
+
+// method returns the name of the accessor of the given register, which is the
+// name of the register with acronyms capitalized (e.g. CwAngleLimit becomes
+// CWAngleLimit).
+func method(n reg.RegName) string {
+	s := n.String()
+	s = strings.Replace(s, "Led", "LED", 1)
+
+	if strings.HasPrefix(s, "Ccw") {
+		s = "CCW" + s[3:]
+	} else if strings.HasPrefix(s, "Cw") {
+		s = "CW" + s[2:]
+	}
+
+	return s
+}
+
+func typeOf(n reg.RegName, prefix string) string {
+	if bools[n] {
+		return "bool"
+	}
+
+	if alarms[n] {
+		return "reg.AlarmFlags"
+	}
+
+	if t, ok := enums[n]; ok {
+		return prefix + t
+	}
+
+	return "int"
+}
+
+// same returns true if the two maps have the same registers, with the same
+// access.
+func same(a, b reg.Map) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for n, r := range a {
+		o, ok := b[n]
+		if !ok || o.Access != r.Access {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sorted(m map[reg.RegName]bool) []reg.RegName {
+	out := make([]reg.RegName, 0, len(m))
+	for n := range m {
+		out = append(out, n)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i] < out[j]
+	})
+
+	return out
+}
+
+func write(path string, b []byte) {
+	src, err := format.Source(b)
+	if err != nil {
+		log.Fatalf("%s: %s", path, err)
+	}
+
+	err = os.WriteFile(path, src, 0644)
+	if err != nil {
+		log.Fatalf("%s: %s", path, err)
+	}
+}
@@ -0,0 +1,298 @@
+package servo
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/adammck/dynamixel/iface"
+	"github.com/adammck/dynamixel/protocol/v1"
+	"github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Group is a set of servos which are commanded together, using the most
+// efficient instructions which their protocols support: SYNC_WRITE (or
+// REG_WRITE and ACTION) to write, and SYNC_READ or BULK_READ to read. The
+// servos can be of different models, and speak different protocols.
+type Group struct {
+	servos []*Servo
+}
+
+// GroupError is returned by the methods of Group when some of the servos
+// failed. It contains the error of each of them, by ID.
+type GroupError map[int]error
+
+func (e GroupError) Error() string {
+	ids := make([]int, 0, len(e))
+	for id := range e {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	msgs := make([]string, len(ids))
+	for i, id := range ids {
+		msgs[i] = fmt.Sprintf("servo %d: %s", id, e[id])
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// NewGroup returns a group of the given servos.
+func NewGroup(servos []*Servo) *Group {
+	return &Group{servos: append([]*Servo(nil), servos...)}
+}
+
+// Servos returns the servos in the group.
+func (g *Group) Servos() []*Servo {
+	return append([]*Servo(nil), g.servos...)
+}
+
+// SetGoalPositions sets the goal position of each servo, in the same order as
+// the group. All of the servos start moving at the same time.
+func (g *Group) SetGoalPositions(positions []int) error {
+	if len(positions) != len(g.servos) {
+		return fmt.Errorf("expected %d positions, got %d", len(g.servos), len(positions))
+	}
+
+	values := make(map[*Servo]int, len(positions))
+	for i, s := range g.servos {
+		values[s] = positions[i]
+	}
+
+	return g.set(reg.GoalPosition, values)
+}
+
+// SetGoalPositionsByID sets the goal positions of the servos with the given
+// IDs. Servos which aren't in the map don't move.
+func (g *Group) SetGoalPositionsByID(positions map[int]int) error {
+	values := make(map[*Servo]int, len(positions))
+	for id := range positions {
+		s := g.byID(id)
+		if s == nil {
+			return fmt.Errorf("servo %d is not in the group", id)
+		}
+
+		values[s] = positions[id]
+	}
+
+	return g.set(reg.GoalPosition, values)
+}
+
+// SetTorqueEnable enables (or disables) the torque of every servo.
+func (g *Group) SetTorqueEnable(v bool) error {
+	return g.setAll(reg.TorqueEnable, v)
+}
+
+// SetLED turns the LED of every servo on (or off).
+func (g *Group) SetLED(v bool) error {
+	return g.setAll(reg.Led, v)
+}
+
+// ReadPresentPositions reads the present position of every servo, by ID.
+// If some of the servos fail, the positions of the others are returned along
+// with a GroupError.
+func (g *Group) ReadPresentPositions() (map[int]int, error) {
+	return g.read(reg.PresentPosition)
+}
+
+func (g *Group) byID(id int) *Servo {
+	for _, s := range g.servos {
+		if s.ID == id {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// bus is the servos in a group which share a protocol and network, and so can
+// be sent broadcast instructions together.
+type bus struct {
+	proto  iface.Protocol
+	servos []*Servo
+}
+
+// buses returns the servos of the group, grouped by bus.
+func (g *Group) buses() []*bus {
+	var out []*bus
+	keys := map[interface{}]*bus{}
+
+	for _, s := range g.servos {
+		k := busKey(s.Protocol)
+		b, ok := keys[k]
+		if !ok {
+			b = &bus{proto: s.Protocol}
+			keys[k] = b
+			out = append(out, b)
+		}
+
+		b.servos = append(b.servos, s)
+	}
+
+	return out
+}
+
+// busKey returns a value which is the same for protocols of the same version
+// which share a network. Each servo usually has its own protocol instance, so
+// they can't be compared directly.
+func busKey(p iface.Protocol) interface{} {
+	type key struct {
+		version int
+		network io.ReadWriter
+	}
+
+	var k key
+	switch p := p.(type) {
+	case *v1.Proto1:
+		k = key{1, p.Network}
+
+	case *v2.Proto2:
+		k = key{2, p.Network}
+
+	default:
+		return p
+	}
+
+	if k.network == nil || !reflect.TypeOf(k.network).Comparable() {
+		return p
+	}
+
+	return k
+}
+
+func (g *Group) setAll(n reg.RegName, v bool) error {
+	i := 0
+	if v {
+		i = 1
+	}
+
+	values := make(map[*Servo]int, len(g.servos))
+	for _, s := range g.servos {
+		values[s] = i
+	}
+
+	return g.set(n, values)
+}
+
+// set writes the given values to register n, as one transaction per bus.
+func (g *Group) set(n reg.RegName, values map[*Servo]int) error {
+	errs := GroupError{}
+
+	for _, b := range g.buses() {
+		tx := NewTransaction(b.proto)
+		var added []*Servo
+
+		for _, s := range b.servos {
+			v, ok := values[s]
+			if !ok {
+				continue
+			}
+
+			err := tx.Set(s, n, v)
+			if err != nil {
+				errs[s.ID] = err
+				continue
+			}
+
+			added = append(added, s)
+		}
+
+		err := tx.Commit()
+		if err != nil {
+			for _, s := range added {
+				errs[s.ID] = err
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// read reads register n of every servo, with as few instructions as possible.
+func (g *Group) read(n reg.RegName) (map[int]int, error) {
+	out := make(map[int]int, len(g.servos))
+	errs := GroupError{}
+
+	for _, b := range g.buses() {
+		data := b.read(n)
+
+		for _, s := range b.servos {
+			r, ok := s.registers[n]
+			if !ok {
+				errs[s.ID] = fmt.Errorf("can't read unsupported register: %v", n)
+				continue
+			}
+
+			var v int
+			var err error
+			if d, ok := data[s.ID]; ok {
+				v, err = r.Decode(d)
+			} else {
+
+				// The broadcast read failed for this servo (or wasn't possible),
+				// so read it by itself.
+				v, err = s.getRegister(n)
+			}
+
+			if err != nil {
+				errs[s.ID] = err
+				continue
+			}
+
+			out[s.ID] = v
+		}
+	}
+
+	if len(errs) > 0 {
+		return out, errs
+	}
+
+	return out, nil
+}
+
+// read reads register n of the servos on the bus with one SYNC_READ or
+// BULK_READ, if the protocol supports them, and returns the data by ID. Servos
+// which aren't included must be read separately.
+func (b *bus) read(n reg.RegName) map[int][]byte {
+	var reads []iface.BulkRead
+	for _, s := range b.servos {
+		if r, ok := s.registers[n]; ok {
+			reads = append(reads, iface.BulkRead{ID: s.ID, Address: r.Address, Length: r.Length})
+		}
+	}
+
+	if len(reads) < 2 {
+		return nil
+	}
+
+	same := true
+	ids := make([]int, len(reads))
+	for i, r := range reads {
+		ids[i] = r.ID
+		if r.Address != reads[0].Address || r.Length != reads[0].Length {
+			same = false
+		}
+	}
+
+	// Errors are ignored, because the servos which failed will be read again
+	// separately, which returns a more specific error.
+	if sr, ok := b.proto.(iface.SyncReader); ok && same {
+		data, _ := sr.SyncRead(reads[0].Address, reads[0].Length, ids)
+		return data
+	}
+
+	if br, ok := b.proto.(iface.BulkReader); ok {
+		data, _ := br.BulkRead(reads)
+		return data
+	}
+
+	return nil
+}
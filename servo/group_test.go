@@ -0,0 +1,106 @@
+package servo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/adammck/dynamixel/iface"
+	"github.com/adammck/dynamixel/protocol/v1"
+	"github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func (b *syncProto) SyncRead(address int, length int, ids []int) (map[int][]byte, error) {
+	b.syncs++
+	out := map[int][]byte{}
+	for _, id := range ids {
+		if id != b.fail {
+			out[id], _ = b.servos[id].ReadData(id, address, length)
+		}
+	}
+
+	return out, nil
+}
+
+func TestGroup(t *testing.T) {
+	m := reg.Map{
+		reg.TorqueEnable:    {Address: 24, Length: 1, Access: reg.RW, Max: 1},
+		reg.Led:             {Address: 25, Length: 1, Access: reg.RW, Max: 1},
+		reg.GoalPosition:    {Address: 30, Length: 2, Access: reg.RW, Max: 1023},
+		reg.PresentPosition: {Address: 36, Length: 2, Access: reg.RO},
+	}
+
+	p1, s1 := servo(m, map[int]byte{36: 0x10})
+	p2, s2 := servo(m, map[int]byte{36: 0x20})
+	p3, s3 := servo(m, map[int]byte{36: 0x30})
+	s2.ID, s3.ID = 2, 3
+
+	bus := &syncProto{busProto: &busProto{servos: map[int]*mockProto{1: p1, 2: p2, 3: p3}}}
+	s1.Protocol, s2.Protocol, s3.Protocol = bus, bus, bus
+
+	g := NewGroup([]*Servo{s1, s2, s3})
+	assert.Len(t, g.buses(), 1)
+
+	assert.NoError(t, g.SetTorqueEnable(true))
+	assert.NoError(t, g.SetLED(true))
+	assert.Equal(t, 2, bus.syncs)
+	for _, p := range []*mockProto{p1, p2, p3} {
+		assert.Equal(t, []byte{1, 1}, p.controlTable[24:26])
+	}
+
+	assert.NoError(t, g.SetGoalPositions([]int{100, 200, 300}))
+	assert.Equal(t, 3, bus.syncs)
+	assert.Equal(t, byte(100), p1.controlTable[30])
+	assert.Equal(t, byte(200), p2.controlTable[30])
+
+	assert.Error(t, g.SetGoalPositions([]int{1}))
+
+	// only some of them
+	assert.NoError(t, g.SetGoalPositionsByID(map[int]int{3: 50}))
+	assert.Equal(t, byte(50), p3.controlTable[30])
+	assert.Equal(t, byte(100), p1.controlTable[30])
+
+	assert.Error(t, g.SetGoalPositionsByID(map[int]int{4: 50}))
+
+	// per-servo errors
+	err := g.SetGoalPositionsByID(map[int]int{1: 10, 2: 2000})
+	assert.EqualError(t, err, "servo 2: value too high: 2000 (max=1023)")
+	assert.Equal(t, byte(10), p1.controlTable[30])
+
+	pos, err := g.ReadPresentPositions()
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]int{1: 0x10, 2: 0x20, 3: 0x30}, pos)
+	assert.Equal(t, 6, bus.syncs)
+
+	// servos missing from the sync read are read separately
+	bus.fail = 2
+	reads := p2.reads
+	pos, err = g.ReadPresentPositions()
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]int{1: 0x10, 2: 0x20, 3: 0x30}, pos)
+	assert.Equal(t, reads+1, p2.reads)
+}
+
+func TestGroupBuses(t *testing.T) {
+	a := &busProto{}
+	b := &busProto{}
+
+	servos := []*Servo{
+		{ID: 1, Protocol: a},
+		{ID: 2, Protocol: b},
+		{ID: 3, Protocol: a},
+	}
+
+	buses := NewGroup(servos).buses()
+	if assert.Len(t, buses, 2) {
+		assert.Equal(t, []*Servo{servos[0], servos[2]}, buses[0].servos)
+		assert.Equal(t, iface.Protocol(b), buses[1].proto)
+	}
+
+	// servos usually have their own protocol, but share the network
+	n := &bytes.Buffer{}
+	assert.True(t, busKey(v1.New(n)) == busKey(v1.New(n)))
+	assert.False(t, busKey(v1.New(n)) == busKey(v2.New(n)))
+	assert.False(t, busKey(v1.New(n)) == busKey(v1.New(&bytes.Buffer{})))
+}
@@ -0,0 +1,317 @@
+package servo
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/utils"
+)
+
+// Indirect is a list of registers which are mapped into the indirect data
+// region of a servo, so that they can be read (or written) in one instruction
+// rather than one per register. Create one with Servo.Indirect, then Program
+// the servo with it (once), then Read and Write as often as you like.
+type Indirect struct {
+	s     *Servo
+	names []reg.RegName
+
+	// The offset of each register in the indirect data, and the total length.
+	offsets []int
+	length  int
+}
+
+// Indirect returns a mapping of the given registers into the indirect data
+// region of the servo, in that order. This doesn't talk to the servo; see
+// Program and Verify. Returns an error if the servo doesn't support indirect
+// addressing, or doesn't have one of the registers, or if they don't fit.
+func (s *Servo) Indirect(names ...reg.RegName) (*Indirect, error) {
+	if s.model == nil || s.model.Indirect.Count == 0 {
+		return nil, errors.New("indirect addressing is not supported")
+	}
+
+	i := &Indirect{s: s}
+	for _, n := range names {
+		r, ok := s.registers[n]
+		if !ok {
+			return nil, fmt.Errorf("can't map unsupported register: %v", n)
+		}
+
+		for _, m := range i.names {
+			if m == n {
+				return nil, fmt.Errorf("duplicate register: %v", n)
+			}
+		}
+
+		i.names = append(i.names, n)
+		i.offsets = append(i.offsets, i.length)
+		i.length += r.Length
+	}
+
+	if i.length > s.model.Indirect.Count {
+		return nil, fmt.Errorf("too many bytes to map: %d (max=%d)", i.length, s.model.Indirect.Count)
+	}
+
+	return i, nil
+}
+
+// Names returns the registers which are mapped, in order.
+func (i *Indirect) Names() []reg.RegName {
+	return append([]reg.RegName(nil), i.names...)
+}
+
+// table returns the contents of the indirect address table which maps the
+// registers: the address of each byte of each register, as two bytes.
+func (i *Indirect) table() []byte {
+	b := make([]byte, 0, i.length*2)
+	for _, n := range i.names {
+		r := i.s.registers[n]
+		for j := 0; j < r.Length; j++ {
+			b = append(b, utils.IntToBytes(r.Address+j, 2)...)
+		}
+	}
+
+	return b
+}
+
+// Program writes the indirect address table of the servo, unless it already
+// contains the mapping. The table is persisted like an EEPROM register, and
+// can only be written while torque is disabled, so see Servo.SetTorqueSafe.
+func (i *Indirect) Program() error {
+	s := i.s
+	b := i.table()
+
+	// As with EEPROM registers, don't write if we don't need to. If the read
+	// fails, write anyway.
+	if err := i.Verify(); err == nil {
+		return nil
+	}
+
+	rl, err := s.ReturnLevel()
+	if err != nil {
+		return err
+	}
+
+	return s.torqueSafely(func() error {
+		err := s.Protocol.WriteData(s.ID, s.model.Indirect.Address, b, rl == 2)
+		if err != nil {
+			return err
+		}
+
+		s.eepromWrites++
+		return nil
+	})
+}
+
+// Verify reads the indirect address table of the servo, and returns an error
+// if it doesn't contain the mapping.
+func (i *Indirect) Verify() error {
+	exp := i.table()
+
+	b, err := i.s.readData(i.s.model.Indirect.Address, len(exp))
+	if err != nil {
+		return err
+	}
+
+	if len(b) != len(exp) {
+		return fmt.Errorf("expected %d bytes, got %d", len(exp), len(b))
+	}
+
+	for j := 0; j < len(exp); j += 2 {
+		if b[j] != exp[j] || b[j+1] != exp[j+1] {
+			return fmt.Errorf("indirect address %d is %d, expected %d", j/2+1, int(b[j])|int(b[j+1])<<8, int(exp[j])|int(exp[j+1])<<8)
+		}
+	}
+
+	return nil
+}
+
+// ReadValues reads the value of every mapped register, in one instruction.
+func (i *Indirect) ReadValues() (map[reg.RegName]int, error) {
+	b, err := i.s.readData(i.s.model.Indirect.Data, i.length)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) != i.length {
+		return nil, fmt.Errorf("expected %d bytes, got %d", i.length, len(b))
+	}
+
+	out := make(map[reg.RegName]int, len(i.names))
+	for j, n := range i.names {
+		r := i.s.registers[n]
+		v, err := r.Decode(b[i.offsets[j] : i.offsets[j]+r.Length])
+		if err != nil {
+			return nil, err
+		}
+
+		out[n] = v
+	}
+
+	return out, nil
+}
+
+// WriteValues writes the value of every mapped register, in one instruction.
+// Every register must be writable, and have a value in range. The write is
+// buffered if the servo is (see Servo.SetBuffered).
+func (i *Indirect) WriteValues(values map[reg.RegName]int) error {
+	s := i.s
+	b := make([]byte, 0, i.length)
+
+	for _, n := range i.names {
+		r := s.registers[n]
+		if r.Access == reg.RO {
+			return fmt.Errorf("can't write to a read-only register: %v", n)
+		}
+
+		v, ok := values[n]
+		if !ok {
+			return fmt.Errorf("missing value of %v", n)
+		}
+
+		if v < r.Min || v > r.Max {
+			return fmt.Errorf("value of %v out of range: %d (min=%d, max=%d)", n, v, r.Min, r.Max)
+		}
+
+		p, err := r.Encode(v)
+		if err != nil {
+			return err
+		}
+
+		b = append(b, p...)
+	}
+
+	rl, err := s.ReturnLevel()
+	if err != nil {
+		return err
+	}
+
+	s.Invalidate(i.names...)
+
+	if s.buffered {
+		return s.Protocol.RegWrite(s.ID, s.model.Indirect.Data, b, rl == 2)
+	}
+
+	return s.Protocol.WriteData(s.ID, s.model.Indirect.Data, b, rl == 2)
+}
+
+// Read reads every mapped register into the given pointer to a struct. Each
+// field is set from the register of the same name (e.g. PresentPosition), or
+// named by its `dxl` tag. Fields must be ints or bools, and tagged `dxl:"-"` if
+// they're not registers. Registers without a field are ignored.
+func (i *Indirect) Read(v interface{}) error {
+	if reflect.ValueOf(v).Kind() != reflect.Ptr {
+		return fmt.Errorf("expected pointer to struct, got %T", v)
+	}
+
+	fields, err := i.fields(v)
+	if err != nil {
+		return err
+	}
+
+	values, err := i.ReadValues()
+	if err != nil {
+		return err
+	}
+
+	for n, f := range fields {
+		switch f.Kind() {
+		case reflect.Bool:
+			f.SetBool(values[n] != 0)
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			f.SetUint(uint64(values[n]))
+
+		default:
+			f.SetInt(int64(values[n]))
+		}
+	}
+
+	return nil
+}
+
+// Write writes every mapped register from the given struct (or pointer to
+// one), which must have a field for each of them. See Read and WriteValues.
+func (i *Indirect) Write(v interface{}) error {
+	fields, err := i.fields(v)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[reg.RegName]int, len(fields))
+	for n, f := range fields {
+		switch f.Kind() {
+		case reflect.Bool:
+			if f.Bool() {
+				values[n] = 1
+			} else {
+				values[n] = 0
+			}
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			values[n] = int(f.Uint())
+
+		default:
+			values[n] = int(f.Int())
+		}
+	}
+
+	return i.WriteValues(values)
+}
+
+// fields returns the fields of the given struct (or pointer to one), by the
+// register which each is mapped to.
+func (i *Indirect) fields(v interface{}) (map[reg.RegName]reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected struct, got %T", v)
+	}
+
+	out := map[reg.RegName]reflect.Value{}
+	rt := rv.Type()
+
+	for j := 0; j < rt.NumField(); j++ {
+		sf := rt.Field(j)
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("dxl"); ok {
+			if tag == "-" {
+				continue
+			}
+
+			name = tag
+		}
+
+		n, ok := reg.ByName(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown register: %s", name)
+		}
+
+		mapped := false
+		for _, m := range i.names {
+			if m == n {
+				mapped = true
+				break
+			}
+		}
+
+		if !mapped {
+			return nil, fmt.Errorf("register not mapped: %v", n)
+		}
+
+		switch sf.Type.Kind() {
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		default:
+			return nil, fmt.Errorf("unsupported type of %s: %s", sf.Name, sf.Type)
+		}
+
+		out[n] = rv.Field(j)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,115 @@
+package servo
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndirect(t *testing.T) {
+	m := reg.Map{
+		roOneByte: &reg.Register{Address: 0x00, Length: 1, Access: reg.RO},
+		rwTwoByte: &reg.Register{Address: 0x02, Length: 2, Access: reg.RW, Min: 0, Max: 2048, Area: reg.RAM},
+	}
+
+	p, s := servo(m, map[int]byte{0x00: 0x07})
+
+	// no model, so no indirect region
+	_, err := s.Indirect(rwTwoByte)
+	assert.EqualError(t, err, "indirect addressing is not supported")
+
+	// addresses at 20-27, data at 28-31
+	s.model = &Model{Indirect: reg.Indirect{Address: 20, Data: 28, Count: 4}}
+
+	_, err = s.Indirect(unsupported)
+	assert.Error(t, err)
+
+	_, err = s.Indirect(rwTwoByte, rwTwoByte)
+	assert.Error(t, err)
+
+	i, err := s.Indirect(rwTwoByte, roOneByte)
+	assert.NoError(t, err)
+	assert.Equal(t, []reg.RegName{rwTwoByte, roOneByte}, i.Names())
+
+	// not programmed yet
+	assert.EqualError(t, i.Verify(), "indirect address 1 is 0, expected 2")
+
+	err = i.Program()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2, 0, 3, 0, 0, 0}, p.controlTable[20:26])
+	assert.NoError(t, i.Verify())
+	assert.Equal(t, 1, s.EEPROMWrites())
+
+	// already programmed, so not written again
+	err = i.Program()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.EEPROMWrites())
+
+	// the mock has no indirection, so fake it
+	p.controlTable[28] = 0x01
+	p.controlTable[29] = 0x04
+	p.controlTable[30] = 0x07
+
+	v, err := i.ReadValues()
+	assert.NoError(t, err)
+	assert.Equal(t, map[reg.RegName]int{rwTwoByte: 1025, roOneByte: 7}, v)
+
+	// read-only registers can't be written
+	err = i.WriteValues(map[reg.RegName]int{rwTwoByte: 1, roOneByte: 1})
+	assert.Error(t, err)
+
+	w, err := s.Indirect(rwTwoByte)
+	assert.NoError(t, err)
+
+	err = w.WriteValues(map[reg.RegName]int{rwTwoByte: 4096})
+	assert.Error(t, err)
+
+	err = w.WriteValues(map[reg.RegName]int{rwTwoByte: 513})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x01, 0x02}, p.controlTable[28:30])
+}
+
+func TestIndirectStruct(t *testing.T) {
+	m := reg.Map{
+		reg.PresentPosition: &reg.Register{Address: 0x00, Length: 2, Access: reg.RO},
+		reg.Moving:          &reg.Register{Address: 0x02, Length: 1, Access: reg.RO},
+		reg.GoalPosition:    &reg.Register{Address: 0x04, Length: 2, Access: reg.RW, Min: 0, Max: 1023},
+	}
+
+	p, s := servo(m, map[int]byte{})
+	s.model = &Model{Indirect: reg.Indirect{Address: 20, Data: 28, Count: 4}}
+
+	i, err := s.Indirect(reg.PresentPosition, reg.Moving)
+	assert.NoError(t, err)
+
+	p.controlTable[28] = 0x00
+	p.controlTable[29] = 0x02
+	p.controlTable[30] = 0x01
+
+	var state struct {
+		Position int `dxl:"PresentPosition"`
+		Moving   bool
+		Name     string `dxl:"-"`
+	}
+
+	err = i.Read(&state)
+	assert.NoError(t, err)
+	assert.Equal(t, 512, state.Position)
+	assert.Equal(t, true, state.Moving)
+
+	// not a pointer
+	assert.Error(t, i.Read(state))
+
+	// field which isn't mapped
+	var goal struct{ GoalPosition int }
+	assert.EqualError(t, i.Read(&goal), "register not mapped: GoalPosition")
+
+	w, err := s.Indirect(reg.GoalPosition)
+	assert.NoError(t, err)
+
+	goal.GoalPosition = 300
+	err = w.Write(goal)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x2c, 0x01}, p.controlTable[28:30])
+}
@@ -0,0 +1,158 @@
+package servo
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/adammck/dynamixel/iface"
+	"github.com/adammck/dynamixel/protocol/v1"
+	"github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Model describes a servo model, so that servos can be configured by their
+// ModelNumber rather than the caller having to know in advance.
+type Model struct {
+	Name string
+
+	// The value of the ModelNumber register. This is unique per protocol, but
+	// the same servo can report different numbers depending on its firmware
+	// (e.g. the MX-28 is 29 with protocol 1, and 30 with protocol 2).
+	Number   int
+	Protocol int
+
+	Registers reg.Map
+
+	// Unit conversions. Steps is the number of positions which span Range
+	// degrees, and Center is the position at the center of that range.
+	Steps  int
+	Range  float64
+	Center int
+
+	// The bits of the AlarmLed, AlarmShutdown and HardwareErrorStatus registers,
+	// if they differ from the protocol 1 error byte.
+	Alarms reg.AlarmBits
+
+	// The indirect address region of the control table, if it has one. See
+	// Servo.Indirect.
+	Indirect reg.Indirect
+}
+
+// PositionToAngle converts a position (as in GoalPosition) to an angle in
+// degrees, where 0 is the center.
+func (m *Model) PositionToAngle(pos int) float64 {
+	return float64(pos-m.Center) * m.Range / float64(m.Steps)
+}
+
+// AngleToPosition converts an angle in degrees, where 0 is the center, to the
+// nearest position.
+func (m *Model) AngleToPosition(angle float64) int {
+	return int(math.Round(angle*float64(m.Steps)/m.Range)) + m.Center
+}
+
+var (
+	modelsMu sync.RWMutex
+	models   = map[[2]int]*Model{}
+)
+
+// RegisterModel makes a model available to Detect. The model packages (e.g.
+// servo/ax) register their models when imported, but other packages can also
+// register their own. It panics if a model with the same number and protocol
+// has already been registered.
+func RegisterModel(m *Model) {
+	modelsMu.Lock()
+	defer modelsMu.Unlock()
+
+	k := [2]int{m.Protocol, m.Number}
+	if _, ok := models[k]; ok {
+		panic(fmt.Sprintf("model already registered: %d (protocol %d)", m.Number, m.Protocol))
+	}
+
+	models[k] = m
+}
+
+// LookupModel returns the registered model with the given number, for the
+// given protocol.
+func LookupModel(protocol int, number int) (*Model, bool) {
+	modelsMu.RLock()
+	defer modelsMu.RUnlock()
+
+	m, ok := models[[2]int{protocol, number}]
+	return m, ok
+}
+
+// Models returns all of the registered models, ordered by protocol and number.
+func Models() []*Model {
+	modelsMu.RLock()
+	defer modelsMu.RUnlock()
+
+	out := make([]*Model, 0, len(models))
+	for _, m := range models {
+		out = append(out, m)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Protocol != out[j].Protocol {
+			return out[i].Protocol < out[j].Protocol
+		}
+
+		return out[i].Number < out[j].Number
+	})
+
+	return out
+}
+
+// modelFor returns the registered model with the given control table, or nil
+// if there isn't one. This allows servos created by the constructors of model
+// packages (e.g. ax.New) to know their model, without detecting it.
+func modelFor(registers reg.Map) *Model {
+	modelsMu.RLock()
+	defer modelsMu.RUnlock()
+
+	p := reflect.ValueOf(registers).Pointer()
+	for _, m := range models {
+		if reflect.ValueOf(m.Registers).Pointer() == p {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// Detect reads the ModelNumber of the servo with the given ID, and returns a
+// Servo configured for that model. Protocol 1 is tried first, then protocol 2.
+// Only registered models can be detected, so import the packages of any models
+// which might be present, e.g.:
+//
+//	import _ "github.com/adammck/dynamixel/servo/x"
+//
+// The servo must respond to READ (i.e. have a Return Level of at least 1).
+func Detect(network io.ReadWriter, ID int) (*Servo, error) {
+	protos := []iface.Protocol{
+		v1.New(network),
+		v2.New(network),
+	}
+
+	for i, p := range protos {
+		b, err := p.ReadData(ID, 0, 2)
+		if err != nil || len(b) != 2 {
+			continue
+		}
+
+		n := int(b[0]) | int(b[1])<<8
+		m, ok := LookupModel(i+1, n)
+		if !ok {
+			return nil, fmt.Errorf("unknown model number: %d (protocol %d)", n, i+1)
+		}
+
+		s := New(p, m.Registers, ID)
+		s.model = m
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("no response from servo %d", ID)
+}
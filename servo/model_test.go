@@ -0,0 +1,86 @@
+package servo_test
+
+import (
+	"testing"
+
+	"github.com/adammck/dynamixel/network"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/adammck/dynamixel/simulator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	bus := simulator.New()
+	bus.Add(simulator.AX12, 1)
+	bus.Add(simulator.XL320, 2)
+	nw := network.New(bus)
+
+	s, err := servo.Detect(nw, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "AX-12", s.Model().Name)
+		assert.Equal(t, 1, s.ID)
+
+		err = s.SetGoalPosition(600)
+		assert.NoError(t, err)
+		assert.Equal(t, 600, bus.Servo(1).Get(reg.GoalPosition))
+	}
+
+	s, err = servo.Detect(nw, 2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "XL-320", s.Model().Name)
+		assert.Equal(t, 2, s.Model().Protocol)
+	}
+
+	_, err = servo.Detect(nw, 3)
+	assert.EqualError(t, err, "no response from servo 3")
+}
+
+func TestLookupModel(t *testing.T) {
+	m, ok := servo.LookupModel(1, 12)
+	if assert.True(t, ok) {
+		assert.Equal(t, "AX-12", m.Name)
+	}
+
+	_, ok = servo.LookupModel(2, 12)
+	assert.False(t, ok)
+
+	assert.Panics(t, func() {
+		servo.RegisterModel(&servo.Model{Name: "AX-12 again", Number: 12, Protocol: 1, Registers: ax.Registers})
+	})
+
+	ms := servo.Models()
+	assert.Equal(t, 1, ms[0].Protocol)
+	assert.Equal(t, 12, ms[0].Number)
+}
+
+func TestModelConversions(t *testing.T) {
+	m := &servo.Model{Steps: 4096, Range: 360, Center: 2048}
+	assert.Equal(t, 0.0, m.PositionToAngle(2048))
+	assert.Equal(t, 90.0, m.PositionToAngle(3072))
+	assert.Equal(t, 1024, m.AngleToPosition(-90))
+
+	m = &servo.Model{Steps: 501922, Range: 360, Center: 0}
+	assert.InDelta(t, -180.0, m.PositionToAngle(-250961), 0.001)
+	assert.Equal(t, 125481, m.AngleToPosition(90))
+}
+
+func TestWrap(t *testing.T) {
+	bus := simulator.New()
+	bus.Add(simulator.AX12, 1)
+
+	s, err := ax.New(network.New(bus), 1)
+	if assert.NoError(t, err) {
+		a := ax.Wrap(s)
+		assert.Equal(t, s, a.Servo())
+
+		err = a.SetLED(true)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, bus.Servo(1).Get(reg.Led))
+
+		v, err := a.CWAngleLimit()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, v)
+	}
+}
@@ -0,0 +1,49 @@
+package servo
+
+import "fmt"
+
+// OperatingMode is the value of the OperatingMode register of protocol 2
+// servos (e.g. the X-series), which selects what the servo controls. Not every
+// model supports every mode; see the docs.
+type OperatingMode int
+
+const (
+	CurrentControl              OperatingMode = 0
+	VelocityControl             OperatingMode = 1
+	PositionControl             OperatingMode = 3
+	ExtendedPositionControl     OperatingMode = 4 // Multi-turn
+	CurrentBasedPositionControl OperatingMode = 5
+	PWMControl                  OperatingMode = 16
+)
+
+// ControlMode is the value of the ControlMode register of the XL-320.
+type ControlMode int
+
+const (
+	WheelMode ControlMode = 1
+	JointMode ControlMode = 2
+)
+
+// Mode is whether a servo moves to a goal position (like a normal servo), or
+// turns continuously at a goal velocity (like a wheel), or moves to a goal
+// position which can be many turns away. See SetMode.
+type Mode int
+
+const (
+	Joint Mode = iota + 1
+	Wheel
+	MultiTurn
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Joint:
+		return "joint"
+	case Wheel:
+		return "wheel"
+	case MultiTurn:
+		return "multi-turn"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
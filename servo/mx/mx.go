@@ -0,0 +1,206 @@
+// Package mx provides the control tables of the MX-28, MX-64 and MX-106. These
+// can run either protocol 1 or protocol 2 firmware, which have entirely
+// different control tables, so each model has two.
+//
+// See: https://emanual.robotis.com/docs/en/dxl/mx/mx-64/
+// See: https://emanual.robotis.com/docs/en/dxl/mx/mx-64-2/
+package mx
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/adammck/dynamixel/iface"
+	"github.com/adammck/dynamixel/protocol/v1"
+	"github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/x"
+)
+
+const (
+
+	// Unit conversions. Every MX has 4096 steps per revolution, with 2048 (180
+	// deg) at the center.
+	maxPos          = 4095
+	positionToAngle = 360.0 / (maxPos + 1) // 0.088
+)
+
+// Control tables of each model, for protocol 1 firmware. The comments are the
+// model numbers.
+var (
+	MX28  reg.Map // 29
+	MX64  reg.Map // 310
+	MX106 reg.Map // 320
+)
+
+// Control tables of each model, for protocol 2 firmware.
+var (
+	MX28V2  reg.Map // 30
+	MX64V2  reg.Map // 311
+	MX106V2 reg.Map // 321
+)
+
+// NewMX28 returns a new MX-28 servo with the given ID, running the firmware of
+// the given protocol (1 or 2).
+func NewMX28(network io.ReadWriter, protocol int, ID int) (*servo.Servo, error) {
+	return newServo(network, protocol, MX28, MX28V2, ID)
+}
+
+// NewMX64 returns a new MX-64 servo. See NewMX28.
+func NewMX64(network io.ReadWriter, protocol int, ID int) (*servo.Servo, error) {
+	return newServo(network, protocol, MX64, MX64V2, ID)
+}
+
+// NewMX106 returns a new MX-106 servo. See NewMX28.
+func NewMX106(network io.ReadWriter, protocol int, ID int) (*servo.Servo, error) {
+	return newServo(network, protocol, MX106, MX106V2, ID)
+}
+
+func newServo(network io.ReadWriter, protocol int, r1, r2 reg.Map, ID int) (*servo.Servo, error) {
+	var p iface.Protocol
+	var r reg.Map
+
+	switch protocol {
+	case 1:
+		p, r = v1.New(network), r1
+
+	case 2:
+		p, r = v2.New(network), r2
+
+	default:
+		return nil, fmt.Errorf("invalid protocol: %d", protocol)
+	}
+
+	return servo.New(p, r, ID), nil
+}
+
+// PositionToAngle converts a position (as in GoalPosition) to an angle in
+// degrees, where 0 is the center.
+func PositionToAngle(pos int) float64 {
+	return float64(pos-2048) * positionToAngle
+}
+
+// AngleToPosition converts an angle in degrees, where 0 is the center, to the
+// nearest position.
+func AngleToPosition(angle float64) int {
+	return int(math.Round(angle/positionToAngle)) + 2048
+}
+
+func init() {
+	MX28 = registers1(false, false)
+	MX64 = registers1(true, false)
+	MX106 = registers1(true, true)
+
+	// The protocol 2 firmware uses the X-series control table. The MX-28 can't
+	// sense current, like the XL430, and the others can, like the XM430.
+	MX28V2 = registers2(x.XL430W250, 0)
+	MX64V2 = registers2(x.XM430W350, 1941)
+	MX106V2 = registers2(x.XM430W350, 2047)
+
+	for _, m := range []struct {
+		name     string
+		number   int
+		protocol int
+		r        reg.Map
+	}{
+		{"MX-28", 29, 1, MX28},
+		{"MX-64", 310, 1, MX64},
+		{"MX-106", 320, 1, MX106},
+		{"MX-28(2.0)", 30, 2, MX28V2},
+		{"MX-64(2.0)", 311, 2, MX64V2},
+		{"MX-106(2.0)", 321, 2, MX106V2},
+	} {
+		var alarms reg.AlarmBits
+		var indirect reg.Indirect
+		if m.protocol == 2 {
+			alarms = x.Alarms
+			indirect = x.Indirect
+		}
+
+		servo.RegisterModel(&servo.Model{Name: m.name, Number: m.number, Protocol: m.protocol, Registers: m.r, Steps: maxPos + 1, Range: 360, Center: 2048, Alarms: alarms, Indirect: indirect})
+	}
+}
+
+func registers1(current bool, driveMode bool) reg.Map {
+	x := 0
+
+	m := reg.Map{
+
+		// EEPROM: Persisted
+		reg.ModelNumber:             {0x00, 2, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.FirmwareVersion:         {0x02, 1, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ServoID:                 {0x03, 1, reg.RW, 0, 252, false, reg.None, 0, reg.EEPROM},
+		reg.BaudRate:                {0x04, 1, reg.RW, 0, 254, false, reg.None, 0, reg.EEPROM}, // bps = 2000000/(value+1), but see docs for 250+
+		reg.ReturnDelayTime:         {0x05, 1, reg.RW, 0, 254, false, reg.Microseconds, 2, reg.EEPROM},
+		reg.CwAngleLimit:            {0x06, 2, reg.RW, 0, 4095, false, reg.Degrees, positionToAngle, reg.EEPROM},
+		reg.CcwAngleLimit:           {0x08, 2, reg.RW, 0, 4095, false, reg.Degrees, positionToAngle, reg.EEPROM}, // both 0 = wheel mode, both 4095 = multi-turn mode
+		reg.HighestLimitTemperature: {0x0b, 1, reg.RW, 0, 99, false, reg.Celsius, 1, reg.EEPROM},
+		reg.LowestLimitVoltage:      {0x0c, 1, reg.RW, 50, 160, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.HighestLimitVoltage:     {0x0d, 1, reg.RW, 50, 160, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.MaxTorque:               {0x0e, 2, reg.RW, 0, 1023, false, reg.Percent, 100.0 / 1023, reg.EEPROM},
+		reg.StatusReturnLevel:       {0x10, 1, reg.RW, 0, 2, false, reg.None, 0, reg.EEPROM},
+		reg.AlarmLed:                {0x11, 1, reg.RW, 0, 127, false, reg.None, 0, reg.EEPROM},
+		reg.AlarmShutdown:           {0x12, 1, reg.RW, 0, 127, false, reg.None, 0, reg.EEPROM},
+		reg.MultiTurnOffset:         {0x14, 2, reg.RW, -24576, 24576, true, reg.Degrees, positionToAngle, reg.EEPROM},
+		reg.ResolutionDivider:       {0x16, 1, reg.RW, 1, 4, false, reg.None, 0, reg.EEPROM},
+
+		// RAM: Reset to default when power-cycled
+		reg.TorqueEnable:          {0x18, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.Led:                   {0x19, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.DGain:                 {0x1a, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},
+		reg.IGain:                 {0x1b, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},
+		reg.PGain:                 {0x1c, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},
+		reg.GoalPosition:          {0x1e, 2, reg.RW, -28672, 28672, true, reg.Degrees, positionToAngle, reg.RAM}, // 2048 (180 deg) is center. negative in multi-turn mode
+		reg.MovingSpeed:           {0x20, 2, reg.RW, 0, 2047, false, reg.RPM, 0.114, reg.RAM},                    // joint mode: 0 = max rpm. wheel mode: see docs
+		reg.TorqueLimit:           {0x22, 2, reg.RW, 0, 1023, false, reg.Percent, 100.0 / 1023, reg.RAM},
+		reg.PresentPosition:       {0x24, 2, reg.RO, x, x, true, reg.Degrees, positionToAngle, reg.RAM},
+		reg.PresentSpeed:          {0x26, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM}, // bit 10 is direction, so no unit
+		reg.PresentLoad:           {0x28, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM}, // bit 10 is direction, so no unit
+		reg.PresentVoltage:        {0x2a, 1, reg.RO, x, x, false, reg.Volts, 0.1, reg.RAM},
+		reg.PresentTemperature:    {0x2b, 1, reg.RO, x, x, false, reg.Celsius, 1, reg.RAM},
+		reg.RegisteredInstruction: {0x2c, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.Moving:                {0x2e, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.Lock:                  {0x2f, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.Punch:                 {0x30, 2, reg.RW, 0, 1023, false, reg.None, 0, reg.RAM},
+		reg.RealtimeTick:          {0x32, 2, reg.RO, x, x, false, reg.Milliseconds, 1, reg.RAM},
+		reg.GoalAcceleration:      {0x49, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM}, // deg/sec^2 = value*8.583
+	}
+
+	if current {
+		m[reg.PresentCurrent] = &reg.Register{0x44, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM} // mA = (value-2048)*4.5
+		m[reg.TorqueControlModeEnable] = &reg.Register{0x46, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM}
+		m[reg.GoalTorque] = &reg.Register{0x47, 2, reg.RW, 0, 2047, false, reg.None, 0, reg.RAM} // bit 10 is direction
+	}
+
+	if driveMode {
+		m[reg.DriveMode] = &reg.Register{0x0a, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM} // bit 0 = reverse, bit 1 = slave
+	}
+
+	return m
+}
+
+// registers2 returns a copy of the given X-series control table, with the MX
+// limits.
+func registers2(base reg.Map, current int) reg.Map {
+	m := base.Copy()
+	m[reg.HighestLimitTemperature].Max = 100
+	m[reg.HighestLimitVoltage].Min = 95
+	m[reg.HighestLimitVoltage].Max = 160
+	m[reg.LowestLimitVoltage].Min = 95
+	m[reg.LowestLimitVoltage].Max = 160
+
+	if current > 0 {
+		m[reg.CurrentLimit].Max = current
+		m[reg.GoalCurrent].Min = -current
+		m[reg.GoalCurrent].Max = current
+
+		// The MX has a bigger current unit than the X-series.
+		for _, n := range []reg.RegName{reg.CurrentLimit, reg.GoalCurrent, reg.PresentCurrent} {
+			m[n].Scale = 3.36
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,1878 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package mx
+
+import (
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+)
+
+// MX28Servo wraps an MX-28 (protocol 1) servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type MX28Servo struct {
+	s *servo.Servo
+}
+
+// WrapMX28 returns a MX28Servo wrapping the given servo.
+func WrapMX28(s *servo.Servo) *MX28Servo {
+	return &MX28Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *MX28Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *MX28Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *MX28Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *MX28Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *MX28Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *MX28Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *MX28Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *MX28Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *MX28Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *MX28Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *MX28Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *MX28Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *MX28Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *MX28Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *MX28Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *MX28Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *MX28Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *MX28Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *MX28Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// MaxTorque returns the value of the MaxTorque register.
+func (w *MX28Servo) MaxTorque() (int, error) {
+	return w.s.MaxTorque()
+}
+
+// SetMaxTorque sets the value of the MaxTorque register.
+func (w *MX28Servo) SetMaxTorque(v int) error {
+	return w.s.SetMaxTorque(v)
+}
+
+// AlarmLED returns the value of the AlarmLed register.
+func (w *MX28Servo) AlarmLED() (reg.AlarmFlags, error) {
+	return w.s.AlarmLED()
+}
+
+// SetAlarmLED sets the value of the AlarmLed register.
+func (w *MX28Servo) SetAlarmLED(v reg.AlarmFlags) error {
+	return w.s.SetAlarmLED(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *MX28Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *MX28Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *MX28Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *MX28Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *MX28Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *MX28Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *MX28Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *MX28Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// MovingSpeed returns the current moving speed. This is not the speed at which
+// the motor is moving, it's the speed at which the servo wants to move.
+func (w *MX28Servo) MovingSpeed() (int, error) {
+	return w.s.MovingSpeed()
+}
+
+// SetMovingSpeed the moving speed.
+//
+// Note: Setting the moving speed appears to reset the TorqueEnabled register to
+//
+//	true, at least on my AX12s.
+func (w *MX28Servo) SetMovingSpeed(v int) error {
+	return w.s.SetMovingSpeed(v)
+}
+
+// TorqueLimit returns the value of the TorqueLimit register.
+func (w *MX28Servo) TorqueLimit() (int, error) {
+	return w.s.TorqueLimit()
+}
+
+// SetTorqueLimit sets the value of the TorqueLimit register.
+func (w *MX28Servo) SetTorqueLimit(v int) error {
+	return w.s.SetTorqueLimit(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *MX28Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *MX28Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentLoad returns the value of the PresentLoad register.
+func (w *MX28Servo) PresentLoad() (int, error) {
+	return w.s.PresentLoad()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *MX28Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *MX28Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *MX28Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *MX28Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// Lock returns the value of the Lock register.
+func (w *MX28Servo) Lock() (bool, error) {
+	return w.s.Lock()
+}
+
+// SetLock sets the value of the Lock register.
+func (w *MX28Servo) SetLock(v bool) error {
+	return w.s.SetLock(v)
+}
+
+// Punch returns the value of the Punch register.
+func (w *MX28Servo) Punch() (int, error) {
+	return w.s.Punch()
+}
+
+// SetPunch sets the value of the Punch register.
+func (w *MX28Servo) SetPunch(v int) error {
+	return w.s.SetPunch(v)
+}
+
+// DGain returns the value of the DGain register.
+func (w *MX28Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *MX28Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *MX28Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *MX28Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *MX28Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *MX28Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *MX28Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// MultiTurnOffset returns the value of the MultiTurnOffset register.
+func (w *MX28Servo) MultiTurnOffset() (int, error) {
+	return w.s.MultiTurnOffset()
+}
+
+// SetMultiTurnOffset sets the value of the MultiTurnOffset register.
+func (w *MX28Servo) SetMultiTurnOffset(v int) error {
+	return w.s.SetMultiTurnOffset(v)
+}
+
+// ResolutionDivider returns the value of the ResolutionDivider register.
+func (w *MX28Servo) ResolutionDivider() (int, error) {
+	return w.s.ResolutionDivider()
+}
+
+// SetResolutionDivider sets the value of the ResolutionDivider register.
+func (w *MX28Servo) SetResolutionDivider(v int) error {
+	return w.s.SetResolutionDivider(v)
+}
+
+// GoalAcceleration returns the value of the GoalAcceleration register.
+func (w *MX28Servo) GoalAcceleration() (int, error) {
+	return w.s.GoalAcceleration()
+}
+
+// SetGoalAcceleration sets the value of the GoalAcceleration register.
+func (w *MX28Servo) SetGoalAcceleration(v int) error {
+	return w.s.SetGoalAcceleration(v)
+}
+
+// MX64Servo wraps an MX-64 (protocol 1) servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type MX64Servo struct {
+	s *servo.Servo
+}
+
+// WrapMX64 returns a MX64Servo wrapping the given servo.
+func WrapMX64(s *servo.Servo) *MX64Servo {
+	return &MX64Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *MX64Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *MX64Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *MX64Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *MX64Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *MX64Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *MX64Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *MX64Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *MX64Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *MX64Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *MX64Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *MX64Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *MX64Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *MX64Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *MX64Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *MX64Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *MX64Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *MX64Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *MX64Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *MX64Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// MaxTorque returns the value of the MaxTorque register.
+func (w *MX64Servo) MaxTorque() (int, error) {
+	return w.s.MaxTorque()
+}
+
+// SetMaxTorque sets the value of the MaxTorque register.
+func (w *MX64Servo) SetMaxTorque(v int) error {
+	return w.s.SetMaxTorque(v)
+}
+
+// AlarmLED returns the value of the AlarmLed register.
+func (w *MX64Servo) AlarmLED() (reg.AlarmFlags, error) {
+	return w.s.AlarmLED()
+}
+
+// SetAlarmLED sets the value of the AlarmLed register.
+func (w *MX64Servo) SetAlarmLED(v reg.AlarmFlags) error {
+	return w.s.SetAlarmLED(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *MX64Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *MX64Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *MX64Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *MX64Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *MX64Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *MX64Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *MX64Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *MX64Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// MovingSpeed returns the current moving speed. This is not the speed at which
+// the motor is moving, it's the speed at which the servo wants to move.
+func (w *MX64Servo) MovingSpeed() (int, error) {
+	return w.s.MovingSpeed()
+}
+
+// SetMovingSpeed the moving speed.
+//
+// Note: Setting the moving speed appears to reset the TorqueEnabled register to
+//
+//	true, at least on my AX12s.
+func (w *MX64Servo) SetMovingSpeed(v int) error {
+	return w.s.SetMovingSpeed(v)
+}
+
+// TorqueLimit returns the value of the TorqueLimit register.
+func (w *MX64Servo) TorqueLimit() (int, error) {
+	return w.s.TorqueLimit()
+}
+
+// SetTorqueLimit sets the value of the TorqueLimit register.
+func (w *MX64Servo) SetTorqueLimit(v int) error {
+	return w.s.SetTorqueLimit(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *MX64Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *MX64Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentLoad returns the value of the PresentLoad register.
+func (w *MX64Servo) PresentLoad() (int, error) {
+	return w.s.PresentLoad()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *MX64Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *MX64Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *MX64Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *MX64Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// Lock returns the value of the Lock register.
+func (w *MX64Servo) Lock() (bool, error) {
+	return w.s.Lock()
+}
+
+// SetLock sets the value of the Lock register.
+func (w *MX64Servo) SetLock(v bool) error {
+	return w.s.SetLock(v)
+}
+
+// Punch returns the value of the Punch register.
+func (w *MX64Servo) Punch() (int, error) {
+	return w.s.Punch()
+}
+
+// SetPunch sets the value of the Punch register.
+func (w *MX64Servo) SetPunch(v int) error {
+	return w.s.SetPunch(v)
+}
+
+// DGain returns the value of the DGain register.
+func (w *MX64Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *MX64Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *MX64Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *MX64Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *MX64Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *MX64Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// GoalTorque returns the value of the GoalTorque register.
+func (w *MX64Servo) GoalTorque() (int, error) {
+	return w.s.GoalTorque()
+}
+
+// SetGoalTorque sets the value of the GoalTorque register.
+func (w *MX64Servo) SetGoalTorque(v int) error {
+	return w.s.SetGoalTorque(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *MX64Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (w *MX64Servo) PresentCurrent() (int, error) {
+	return w.s.PresentCurrent()
+}
+
+// MultiTurnOffset returns the value of the MultiTurnOffset register.
+func (w *MX64Servo) MultiTurnOffset() (int, error) {
+	return w.s.MultiTurnOffset()
+}
+
+// SetMultiTurnOffset sets the value of the MultiTurnOffset register.
+func (w *MX64Servo) SetMultiTurnOffset(v int) error {
+	return w.s.SetMultiTurnOffset(v)
+}
+
+// ResolutionDivider returns the value of the ResolutionDivider register.
+func (w *MX64Servo) ResolutionDivider() (int, error) {
+	return w.s.ResolutionDivider()
+}
+
+// SetResolutionDivider sets the value of the ResolutionDivider register.
+func (w *MX64Servo) SetResolutionDivider(v int) error {
+	return w.s.SetResolutionDivider(v)
+}
+
+// GoalAcceleration returns the value of the GoalAcceleration register.
+func (w *MX64Servo) GoalAcceleration() (int, error) {
+	return w.s.GoalAcceleration()
+}
+
+// SetGoalAcceleration sets the value of the GoalAcceleration register.
+func (w *MX64Servo) SetGoalAcceleration(v int) error {
+	return w.s.SetGoalAcceleration(v)
+}
+
+// TorqueControlModeEnable returns the value of the TorqueControlModeEnable register.
+func (w *MX64Servo) TorqueControlModeEnable() (bool, error) {
+	return w.s.TorqueControlModeEnable()
+}
+
+// SetTorqueControlModeEnable sets the value of the TorqueControlModeEnable register.
+func (w *MX64Servo) SetTorqueControlModeEnable(v bool) error {
+	return w.s.SetTorqueControlModeEnable(v)
+}
+
+// MX106Servo wraps an MX-106 (protocol 1) servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type MX106Servo struct {
+	s *servo.Servo
+}
+
+// WrapMX106 returns a MX106Servo wrapping the given servo.
+func WrapMX106(s *servo.Servo) *MX106Servo {
+	return &MX106Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *MX106Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *MX106Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *MX106Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *MX106Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *MX106Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *MX106Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *MX106Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *MX106Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *MX106Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *MX106Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *MX106Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *MX106Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *MX106Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *MX106Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *MX106Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *MX106Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *MX106Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *MX106Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *MX106Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// MaxTorque returns the value of the MaxTorque register.
+func (w *MX106Servo) MaxTorque() (int, error) {
+	return w.s.MaxTorque()
+}
+
+// SetMaxTorque sets the value of the MaxTorque register.
+func (w *MX106Servo) SetMaxTorque(v int) error {
+	return w.s.SetMaxTorque(v)
+}
+
+// AlarmLED returns the value of the AlarmLed register.
+func (w *MX106Servo) AlarmLED() (reg.AlarmFlags, error) {
+	return w.s.AlarmLED()
+}
+
+// SetAlarmLED sets the value of the AlarmLed register.
+func (w *MX106Servo) SetAlarmLED(v reg.AlarmFlags) error {
+	return w.s.SetAlarmLED(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *MX106Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *MX106Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *MX106Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *MX106Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *MX106Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *MX106Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *MX106Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *MX106Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// MovingSpeed returns the current moving speed. This is not the speed at which
+// the motor is moving, it's the speed at which the servo wants to move.
+func (w *MX106Servo) MovingSpeed() (int, error) {
+	return w.s.MovingSpeed()
+}
+
+// SetMovingSpeed the moving speed.
+//
+// Note: Setting the moving speed appears to reset the TorqueEnabled register to
+//
+//	true, at least on my AX12s.
+func (w *MX106Servo) SetMovingSpeed(v int) error {
+	return w.s.SetMovingSpeed(v)
+}
+
+// TorqueLimit returns the value of the TorqueLimit register.
+func (w *MX106Servo) TorqueLimit() (int, error) {
+	return w.s.TorqueLimit()
+}
+
+// SetTorqueLimit sets the value of the TorqueLimit register.
+func (w *MX106Servo) SetTorqueLimit(v int) error {
+	return w.s.SetTorqueLimit(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *MX106Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *MX106Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentLoad returns the value of the PresentLoad register.
+func (w *MX106Servo) PresentLoad() (int, error) {
+	return w.s.PresentLoad()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *MX106Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *MX106Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *MX106Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *MX106Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// Lock returns the value of the Lock register.
+func (w *MX106Servo) Lock() (bool, error) {
+	return w.s.Lock()
+}
+
+// SetLock sets the value of the Lock register.
+func (w *MX106Servo) SetLock(v bool) error {
+	return w.s.SetLock(v)
+}
+
+// Punch returns the value of the Punch register.
+func (w *MX106Servo) Punch() (int, error) {
+	return w.s.Punch()
+}
+
+// SetPunch sets the value of the Punch register.
+func (w *MX106Servo) SetPunch(v int) error {
+	return w.s.SetPunch(v)
+}
+
+// DGain returns the value of the DGain register.
+func (w *MX106Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *MX106Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *MX106Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *MX106Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *MX106Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *MX106Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// GoalTorque returns the value of the GoalTorque register.
+func (w *MX106Servo) GoalTorque() (int, error) {
+	return w.s.GoalTorque()
+}
+
+// SetGoalTorque sets the value of the GoalTorque register.
+func (w *MX106Servo) SetGoalTorque(v int) error {
+	return w.s.SetGoalTorque(v)
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (w *MX106Servo) DriveMode() (int, error) {
+	return w.s.DriveMode()
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (w *MX106Servo) SetDriveMode(v int) error {
+	return w.s.SetDriveMode(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *MX106Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (w *MX106Servo) PresentCurrent() (int, error) {
+	return w.s.PresentCurrent()
+}
+
+// MultiTurnOffset returns the value of the MultiTurnOffset register.
+func (w *MX106Servo) MultiTurnOffset() (int, error) {
+	return w.s.MultiTurnOffset()
+}
+
+// SetMultiTurnOffset sets the value of the MultiTurnOffset register.
+func (w *MX106Servo) SetMultiTurnOffset(v int) error {
+	return w.s.SetMultiTurnOffset(v)
+}
+
+// ResolutionDivider returns the value of the ResolutionDivider register.
+func (w *MX106Servo) ResolutionDivider() (int, error) {
+	return w.s.ResolutionDivider()
+}
+
+// SetResolutionDivider sets the value of the ResolutionDivider register.
+func (w *MX106Servo) SetResolutionDivider(v int) error {
+	return w.s.SetResolutionDivider(v)
+}
+
+// GoalAcceleration returns the value of the GoalAcceleration register.
+func (w *MX106Servo) GoalAcceleration() (int, error) {
+	return w.s.GoalAcceleration()
+}
+
+// SetGoalAcceleration sets the value of the GoalAcceleration register.
+func (w *MX106Servo) SetGoalAcceleration(v int) error {
+	return w.s.SetGoalAcceleration(v)
+}
+
+// TorqueControlModeEnable returns the value of the TorqueControlModeEnable register.
+func (w *MX106Servo) TorqueControlModeEnable() (bool, error) {
+	return w.s.TorqueControlModeEnable()
+}
+
+// SetTorqueControlModeEnable sets the value of the TorqueControlModeEnable register.
+func (w *MX106Servo) SetTorqueControlModeEnable(v bool) error {
+	return w.s.SetTorqueControlModeEnable(v)
+}
+
+// MX28V2Servo wraps an MX-28 (protocol 2) servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type MX28V2Servo struct {
+	s *servo.Servo
+}
+
+// WrapMX28V2 returns a MX28V2Servo wrapping the given servo.
+func WrapMX28V2(s *servo.Servo) *MX28V2Servo {
+	return &MX28V2Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *MX28V2Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *MX28V2Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *MX28V2Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *MX28V2Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *MX28V2Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *MX28V2Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *MX28V2Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *MX28V2Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *MX28V2Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *MX28V2Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *MX28V2Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *MX28V2Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *MX28V2Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *MX28V2Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *MX28V2Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *MX28V2Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *MX28V2Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *MX28V2Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *MX28V2Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *MX28V2Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *MX28V2Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *MX28V2Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *MX28V2Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *MX28V2Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *MX28V2Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *MX28V2Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *MX28V2Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *MX28V2Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *MX28V2Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentLoad returns the value of the PresentLoad register.
+func (w *MX28V2Servo) PresentLoad() (int, error) {
+	return w.s.PresentLoad()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *MX28V2Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *MX28V2Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *MX28V2Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *MX28V2Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// DGain returns the value of the DGain register.
+func (w *MX28V2Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *MX28V2Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *MX28V2Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *MX28V2Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *MX28V2Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *MX28V2Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *MX28V2Servo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *MX28V2Servo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *MX28V2Servo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (w *MX28V2Servo) ModelInformation() (int, error) {
+	return w.s.ModelInformation()
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (w *MX28V2Servo) DriveMode() (int, error) {
+	return w.s.DriveMode()
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (w *MX28V2Servo) SetDriveMode(v int) error {
+	return w.s.SetDriveMode(v)
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (w *MX28V2Servo) OperatingMode() (servo.OperatingMode, error) {
+	return w.s.OperatingMode()
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (w *MX28V2Servo) SetOperatingMode(v servo.OperatingMode) error {
+	return w.s.SetOperatingMode(v)
+}
+
+// SecondaryID returns the value of the SecondaryID register.
+func (w *MX28V2Servo) SecondaryID() (int, error) {
+	return w.s.SecondaryID()
+}
+
+// SetSecondaryID sets the value of the SecondaryID register.
+func (w *MX28V2Servo) SetSecondaryID(v int) error {
+	return w.s.SetSecondaryID(v)
+}
+
+// ProtocolType returns the value of the ProtocolType register.
+func (w *MX28V2Servo) ProtocolType() (int, error) {
+	return w.s.ProtocolType()
+}
+
+// SetProtocolType sets the value of the ProtocolType register.
+func (w *MX28V2Servo) SetProtocolType(v int) error {
+	return w.s.SetProtocolType(v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (w *MX28V2Servo) HomingOffset() (int, error) {
+	return w.s.HomingOffset()
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (w *MX28V2Servo) SetHomingOffset(v int) error {
+	return w.s.SetHomingOffset(v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (w *MX28V2Servo) MovingThreshold() (int, error) {
+	return w.s.MovingThreshold()
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (w *MX28V2Servo) SetMovingThreshold(v int) error {
+	return w.s.SetMovingThreshold(v)
+}
+
+// PWMLimit returns the value of the PWMLimit register.
+func (w *MX28V2Servo) PWMLimit() (int, error) {
+	return w.s.PWMLimit()
+}
+
+// SetPWMLimit sets the value of the PWMLimit register.
+func (w *MX28V2Servo) SetPWMLimit(v int) error {
+	return w.s.SetPWMLimit(v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (w *MX28V2Servo) VelocityLimit() (int, error) {
+	return w.s.VelocityLimit()
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (w *MX28V2Servo) SetVelocityLimit(v int) error {
+	return w.s.SetVelocityLimit(v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (w *MX28V2Servo) VelocityIGain() (int, error) {
+	return w.s.VelocityIGain()
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (w *MX28V2Servo) SetVelocityIGain(v int) error {
+	return w.s.SetVelocityIGain(v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (w *MX28V2Servo) VelocityPGain() (int, error) {
+	return w.s.VelocityPGain()
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (w *MX28V2Servo) SetVelocityPGain(v int) error {
+	return w.s.SetVelocityPGain(v)
+}
+
+// Feedforward2ndGain returns the value of the Feedforward2ndGain register.
+func (w *MX28V2Servo) Feedforward2ndGain() (int, error) {
+	return w.s.Feedforward2ndGain()
+}
+
+// SetFeedforward2ndGain sets the value of the Feedforward2ndGain register.
+func (w *MX28V2Servo) SetFeedforward2ndGain(v int) error {
+	return w.s.SetFeedforward2ndGain(v)
+}
+
+// Feedforward1stGain returns the value of the Feedforward1stGain register.
+func (w *MX28V2Servo) Feedforward1stGain() (int, error) {
+	return w.s.Feedforward1stGain()
+}
+
+// SetFeedforward1stGain sets the value of the Feedforward1stGain register.
+func (w *MX28V2Servo) SetFeedforward1stGain(v int) error {
+	return w.s.SetFeedforward1stGain(v)
+}
+
+// BusWatchdog returns the value of the BusWatchdog register.
+func (w *MX28V2Servo) BusWatchdog() (int, error) {
+	return w.s.BusWatchdog()
+}
+
+// SetBusWatchdog sets the value of the BusWatchdog register.
+func (w *MX28V2Servo) SetBusWatchdog(v int) error {
+	return w.s.SetBusWatchdog(v)
+}
+
+// GoalPWM returns the value of the GoalPWM register.
+func (w *MX28V2Servo) GoalPWM() (int, error) {
+	return w.s.GoalPWM()
+}
+
+// SetGoalPWM sets the value of the GoalPWM register.
+func (w *MX28V2Servo) SetGoalPWM(v int) error {
+	return w.s.SetGoalPWM(v)
+}
+
+// ProfileAcceleration returns the value of the ProfileAcceleration register.
+func (w *MX28V2Servo) ProfileAcceleration() (int, error) {
+	return w.s.ProfileAcceleration()
+}
+
+// SetProfileAcceleration sets the value of the ProfileAcceleration register.
+func (w *MX28V2Servo) SetProfileAcceleration(v int) error {
+	return w.s.SetProfileAcceleration(v)
+}
+
+// ProfileVelocity returns the value of the ProfileVelocity register.
+func (w *MX28V2Servo) ProfileVelocity() (int, error) {
+	return w.s.ProfileVelocity()
+}
+
+// SetProfileVelocity sets the value of the ProfileVelocity register.
+func (w *MX28V2Servo) SetProfileVelocity(v int) error {
+	return w.s.SetProfileVelocity(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *MX28V2Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// MovingStatus returns the value of the MovingStatus register.
+func (w *MX28V2Servo) MovingStatus() (int, error) {
+	return w.s.MovingStatus()
+}
+
+// PresentPWM returns the value of the PresentPWM register.
+func (w *MX28V2Servo) PresentPWM() (int, error) {
+	return w.s.PresentPWM()
+}
+
+// VelocityTrajectory returns the value of the VelocityTrajectory register.
+func (w *MX28V2Servo) VelocityTrajectory() (int, error) {
+	return w.s.VelocityTrajectory()
+}
+
+// PositionTrajectory returns the value of the PositionTrajectory register.
+func (w *MX28V2Servo) PositionTrajectory() (int, error) {
+	return w.s.PositionTrajectory()
+}
+
+// MX64V2Servo wraps an MX-64 or MX-106 (protocol 2) servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type MX64V2Servo struct {
+	s *servo.Servo
+}
+
+// WrapMX64V2 returns a MX64V2Servo wrapping the given servo.
+func WrapMX64V2(s *servo.Servo) *MX64V2Servo {
+	return &MX64V2Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *MX64V2Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *MX64V2Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *MX64V2Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *MX64V2Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *MX64V2Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *MX64V2Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *MX64V2Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *MX64V2Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *MX64V2Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *MX64V2Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *MX64V2Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *MX64V2Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *MX64V2Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *MX64V2Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *MX64V2Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *MX64V2Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *MX64V2Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *MX64V2Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *MX64V2Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *MX64V2Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *MX64V2Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *MX64V2Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *MX64V2Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *MX64V2Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *MX64V2Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *MX64V2Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *MX64V2Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *MX64V2Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *MX64V2Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *MX64V2Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *MX64V2Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *MX64V2Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *MX64V2Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// DGain returns the value of the DGain register.
+func (w *MX64V2Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *MX64V2Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *MX64V2Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *MX64V2Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *MX64V2Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *MX64V2Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *MX64V2Servo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *MX64V2Servo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *MX64V2Servo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (w *MX64V2Servo) ModelInformation() (int, error) {
+	return w.s.ModelInformation()
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (w *MX64V2Servo) DriveMode() (int, error) {
+	return w.s.DriveMode()
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (w *MX64V2Servo) SetDriveMode(v int) error {
+	return w.s.SetDriveMode(v)
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (w *MX64V2Servo) OperatingMode() (servo.OperatingMode, error) {
+	return w.s.OperatingMode()
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (w *MX64V2Servo) SetOperatingMode(v servo.OperatingMode) error {
+	return w.s.SetOperatingMode(v)
+}
+
+// SecondaryID returns the value of the SecondaryID register.
+func (w *MX64V2Servo) SecondaryID() (int, error) {
+	return w.s.SecondaryID()
+}
+
+// SetSecondaryID sets the value of the SecondaryID register.
+func (w *MX64V2Servo) SetSecondaryID(v int) error {
+	return w.s.SetSecondaryID(v)
+}
+
+// ProtocolType returns the value of the ProtocolType register.
+func (w *MX64V2Servo) ProtocolType() (int, error) {
+	return w.s.ProtocolType()
+}
+
+// SetProtocolType sets the value of the ProtocolType register.
+func (w *MX64V2Servo) SetProtocolType(v int) error {
+	return w.s.SetProtocolType(v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (w *MX64V2Servo) HomingOffset() (int, error) {
+	return w.s.HomingOffset()
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (w *MX64V2Servo) SetHomingOffset(v int) error {
+	return w.s.SetHomingOffset(v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (w *MX64V2Servo) MovingThreshold() (int, error) {
+	return w.s.MovingThreshold()
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (w *MX64V2Servo) SetMovingThreshold(v int) error {
+	return w.s.SetMovingThreshold(v)
+}
+
+// PWMLimit returns the value of the PWMLimit register.
+func (w *MX64V2Servo) PWMLimit() (int, error) {
+	return w.s.PWMLimit()
+}
+
+// SetPWMLimit sets the value of the PWMLimit register.
+func (w *MX64V2Servo) SetPWMLimit(v int) error {
+	return w.s.SetPWMLimit(v)
+}
+
+// CurrentLimit returns the value of the CurrentLimit register.
+func (w *MX64V2Servo) CurrentLimit() (int, error) {
+	return w.s.CurrentLimit()
+}
+
+// SetCurrentLimit sets the value of the CurrentLimit register.
+func (w *MX64V2Servo) SetCurrentLimit(v int) error {
+	return w.s.SetCurrentLimit(v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (w *MX64V2Servo) VelocityLimit() (int, error) {
+	return w.s.VelocityLimit()
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (w *MX64V2Servo) SetVelocityLimit(v int) error {
+	return w.s.SetVelocityLimit(v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (w *MX64V2Servo) VelocityIGain() (int, error) {
+	return w.s.VelocityIGain()
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (w *MX64V2Servo) SetVelocityIGain(v int) error {
+	return w.s.SetVelocityIGain(v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (w *MX64V2Servo) VelocityPGain() (int, error) {
+	return w.s.VelocityPGain()
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (w *MX64V2Servo) SetVelocityPGain(v int) error {
+	return w.s.SetVelocityPGain(v)
+}
+
+// Feedforward2ndGain returns the value of the Feedforward2ndGain register.
+func (w *MX64V2Servo) Feedforward2ndGain() (int, error) {
+	return w.s.Feedforward2ndGain()
+}
+
+// SetFeedforward2ndGain sets the value of the Feedforward2ndGain register.
+func (w *MX64V2Servo) SetFeedforward2ndGain(v int) error {
+	return w.s.SetFeedforward2ndGain(v)
+}
+
+// Feedforward1stGain returns the value of the Feedforward1stGain register.
+func (w *MX64V2Servo) Feedforward1stGain() (int, error) {
+	return w.s.Feedforward1stGain()
+}
+
+// SetFeedforward1stGain sets the value of the Feedforward1stGain register.
+func (w *MX64V2Servo) SetFeedforward1stGain(v int) error {
+	return w.s.SetFeedforward1stGain(v)
+}
+
+// BusWatchdog returns the value of the BusWatchdog register.
+func (w *MX64V2Servo) BusWatchdog() (int, error) {
+	return w.s.BusWatchdog()
+}
+
+// SetBusWatchdog sets the value of the BusWatchdog register.
+func (w *MX64V2Servo) SetBusWatchdog(v int) error {
+	return w.s.SetBusWatchdog(v)
+}
+
+// GoalPWM returns the value of the GoalPWM register.
+func (w *MX64V2Servo) GoalPWM() (int, error) {
+	return w.s.GoalPWM()
+}
+
+// SetGoalPWM sets the value of the GoalPWM register.
+func (w *MX64V2Servo) SetGoalPWM(v int) error {
+	return w.s.SetGoalPWM(v)
+}
+
+// GoalCurrent returns the value of the GoalCurrent register.
+func (w *MX64V2Servo) GoalCurrent() (int, error) {
+	return w.s.GoalCurrent()
+}
+
+// SetGoalCurrent sets the value of the GoalCurrent register.
+func (w *MX64V2Servo) SetGoalCurrent(v int) error {
+	return w.s.SetGoalCurrent(v)
+}
+
+// ProfileAcceleration returns the value of the ProfileAcceleration register.
+func (w *MX64V2Servo) ProfileAcceleration() (int, error) {
+	return w.s.ProfileAcceleration()
+}
+
+// SetProfileAcceleration sets the value of the ProfileAcceleration register.
+func (w *MX64V2Servo) SetProfileAcceleration(v int) error {
+	return w.s.SetProfileAcceleration(v)
+}
+
+// ProfileVelocity returns the value of the ProfileVelocity register.
+func (w *MX64V2Servo) ProfileVelocity() (int, error) {
+	return w.s.ProfileVelocity()
+}
+
+// SetProfileVelocity sets the value of the ProfileVelocity register.
+func (w *MX64V2Servo) SetProfileVelocity(v int) error {
+	return w.s.SetProfileVelocity(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *MX64V2Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// MovingStatus returns the value of the MovingStatus register.
+func (w *MX64V2Servo) MovingStatus() (int, error) {
+	return w.s.MovingStatus()
+}
+
+// PresentPWM returns the value of the PresentPWM register.
+func (w *MX64V2Servo) PresentPWM() (int, error) {
+	return w.s.PresentPWM()
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (w *MX64V2Servo) PresentCurrent() (int, error) {
+	return w.s.PresentCurrent()
+}
+
+// VelocityTrajectory returns the value of the VelocityTrajectory register.
+func (w *MX64V2Servo) VelocityTrajectory() (int, error) {
+	return w.s.VelocityTrajectory()
+}
+
+// PositionTrajectory returns the value of the PositionTrajectory register.
+func (w *MX64V2Servo) PositionTrajectory() (int, error) {
+	return w.s.PositionTrajectory()
+}
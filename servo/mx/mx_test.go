@@ -0,0 +1,45 @@
+package mx
+
+import (
+	"bytes"
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisters(t *testing.T) {
+	assert.Equal(t, 0x1e, MX28[reg.GoalPosition].Address)
+	assert.NotContains(t, MX28, reg.PresentCurrent)
+	assert.Contains(t, MX64, reg.PresentCurrent)
+	assert.NotContains(t, MX64, reg.DriveMode)
+	assert.Contains(t, MX106, reg.DriveMode)
+
+	assert.Equal(t, 116, MX28V2[reg.GoalPosition].Address)
+	assert.Contains(t, MX28V2, reg.PresentLoad)
+	assert.Equal(t, 1941, MX64V2[reg.CurrentLimit].Max)
+	assert.Equal(t, -2047, MX106V2[reg.GoalCurrent].Min)
+
+	// The X-series tables which the protocol 2 tables are copied from must not
+	// have been modified.
+	assert.NotEqual(t, MX64V2[reg.CurrentLimit].Max, MX106V2[reg.CurrentLimit].Max)
+}
+
+func TestNew(t *testing.T) {
+	s, err := NewMX64(&bytes.Buffer{}, 2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.ID)
+
+	_, err = NewMX64(&bytes.Buffer{}, 3, 1)
+	assert.EqualError(t, err, "invalid protocol: 3")
+}
+
+func TestConversions(t *testing.T) {
+	assert.Equal(t, 0.0, PositionToAngle(2048))
+	assert.Equal(t, -180.0, PositionToAngle(0))
+	assert.InDelta(t, 179.912, PositionToAngle(4095), 0.001)
+
+	assert.Equal(t, 2048, AngleToPosition(0))
+	assert.Equal(t, 3072, AngleToPosition(90))
+	assert.Equal(t, 1024, AngleToPosition(-90))
+}
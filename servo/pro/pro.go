@@ -0,0 +1,239 @@
+// Package pro provides the control tables of the DYNAMIXEL PRO (H54, H42, M54
+// and M42) and the newer P-series (PH54, PH42, PM54 and PM42). Both speak
+// protocol 2, with control tables which extend well beyond address 255.
+//
+// See: https://emanual.robotis.com/docs/en/dxl/pro/h54-200-s500-r/
+// See: https://emanual.robotis.com/docs/en/dxl/p/ph54-200-s500-r/
+package pro
+
+import (
+	"io"
+
+	"github.com/adammck/dynamixel/iface"
+	"github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+)
+
+// New returns a new PRO or P-series servo with the given ID, using the given
+// control table (e.g. H54200S500R).
+func New(network io.ReadWriter, registers reg.Map, ID int) (*servo.Servo, error) {
+	return servo.New(v2.New(network), registers, ID), nil
+}
+
+func NewWithReturnLevel(n iface.Protocol, registers reg.Map, ID int, returnLevel int) (*servo.Servo, error) {
+	return servo.NewWithReturnLevel(n, registers, ID, returnLevel), nil
+}
+
+// Control tables of each PRO model. The comments are the model numbers.
+var (
+	H54200S500R reg.Map // 54024
+	H54100S500R reg.Map // 53768
+	H4220S300R  reg.Map // 51200
+	M5460S250R  reg.Map // 46352
+	M5440S250R  reg.Map // 46096
+	M4210S260R  reg.Map // 43288
+)
+
+// Control tables of each P-series model.
+var (
+	PH54200S500R reg.Map // 2020
+	PH54100S500R reg.Map // 2010
+	PH42020S300R reg.Map // 2000
+	PM54060S250R reg.Map // 2120
+	PM54040S250R reg.Map // 2110
+	PM42010S260R reg.Map // 2100
+)
+
+// The bits of the Shutdown and HardwareErrorStatus registers.
+var alarms = reg.AlarmBits{reg.InputVoltage, reg.HallSensor, reg.Overheating, reg.MotorEncoder, reg.ElectricalShock, reg.Overload}
+
+// The indirect address regions. The PRO has 256 indirect addresses, and the
+// P-series has 128, but they both put the data at the same address.
+var (
+	indirectPro = reg.Indirect{Address: 49, Data: 634, Count: 256}
+	indirectP   = reg.Indirect{Address: 168, Data: 634, Count: 128}
+)
+
+// limits are the parts of the control table which vary between models.
+type limits struct {
+
+	// The max position in either direction from zero. Positions are signed,
+	// with zero at the center.
+	position int
+
+	// The max velocity, and the max torque (PRO) or current (P-series), in the
+	// units of the model. See the docs.
+	velocity int
+	torque   int
+}
+
+func init() {
+	for _, m := range []struct {
+		name     string
+		number   int
+		r        *reg.Map
+		p        bool
+		position int
+		velocity int
+		torque   int
+	}{
+		{"H54-200-S500-R", 54024, &H54200S500R, false, 250961, 17000, 620},
+		{"H54-100-S500-R", 53768, &H54100S500R, false, 250961, 17000, 310},
+		{"H42-20-S300-R", 51200, &H4220S300R, false, 151875, 10300, 465},
+		{"M54-60-S250-R", 46352, &M5460S250R, false, 251417, 8000, 180},
+		{"M54-40-S250-R", 46096, &M5440S250R, false, 251417, 8000, 180},
+		{"M42-10-S260-R", 43288, &M4210S260R, false, 131593, 8000, 300},
+		{"PH54-200-S500-R", 2020, &PH54200S500R, true, 501433, 2900, 22740},
+		{"PH54-100-S500-R", 2010, &PH54100S500R, true, 501433, 2900, 15900},
+		{"PH42-020-S300-R", 2000, &PH42020S300R, true, 303454, 2900, 4500},
+		{"PM54-060-S250-R", 2120, &PM54060S250R, true, 251173, 2900, 7980},
+		{"PM54-040-S250-R", 2110, &PM54040S250R, true, 251173, 2900, 4470},
+		{"PM42-010-S260-R", 2100, &PM42010S260R, true, 262931, 2900, 1740},
+	} {
+		l := limits{m.position, m.velocity, m.torque}
+		indirect := indirectPro
+		if m.p {
+			*m.r = registersP(l)
+			indirect = indirectP
+		} else {
+			*m.r = registersPro(l)
+		}
+
+		// Positions are signed, with zero at the center, and span one turn.
+		servo.RegisterModel(&servo.Model{Name: m.name, Number: m.number, Protocol: 2, Registers: *m.r, Steps: 2 * m.position, Range: 360, Center: 0, Alarms: alarms, Indirect: indirect})
+	}
+}
+
+func registersPro(l limits) reg.Map {
+	x := 0
+	pos := l.position
+	deg := 180.0 / float64(pos)
+
+	return reg.Map{
+
+		// EEPROM: Persisted. Can only be written while torque is disabled.
+		reg.ModelNumber:             {0, 2, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ModelInformation:        {2, 4, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.FirmwareVersion:         {6, 1, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ServoID:                 {7, 1, reg.RW, 0, 252, false, reg.None, 0, reg.EEPROM},
+		reg.BaudRate:                {8, 1, reg.RW, 0, 8, false, reg.None, 0, reg.EEPROM}, // 0=9600, 1=57600, 2=115200, 3=1M, 4=2M, 5=3M, 6=4M, 7=4.5M, 8=10.5M
+		reg.ReturnDelayTime:         {9, 1, reg.RW, 0, 254, false, reg.Microseconds, 2, reg.EEPROM},
+		reg.OperatingMode:           {11, 1, reg.RW, 0, 4, false, reg.None, 0, reg.EEPROM}, // 0=torque, 1=velocity, 3=position, 4=extended position
+		reg.HomingOffset:            {13, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.EEPROM},
+		reg.MovingThreshold:         {17, 4, reg.RW, 0, l.velocity, false, reg.None, 0, reg.EEPROM},
+		reg.HighestLimitTemperature: {21, 1, reg.RW, 0, 100, false, reg.Celsius, 1, reg.EEPROM},
+		reg.HighestLimitVoltage:     {22, 2, reg.RW, 150, 400, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.LowestLimitVoltage:      {24, 2, reg.RW, 150, 400, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.AccelerationLimit:       {26, 4, reg.RW, 0, 2147483647, false, reg.None, 0, reg.EEPROM},
+		reg.TorqueLimit:             {30, 2, reg.RW, 0, l.torque, false, reg.None, 0, reg.EEPROM},
+		reg.VelocityLimit:           {32, 4, reg.RW, 0, l.velocity, false, reg.None, 0, reg.EEPROM},
+		reg.CcwAngleLimit:           {36, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.EEPROM}, // Max Position Limit
+		reg.CwAngleLimit:            {40, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.EEPROM}, // Min Position Limit
+		reg.ExternalPortMode1:       {44, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.ExternalPortMode2:       {45, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.ExternalPortMode3:       {46, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.ExternalPortMode4:       {47, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.AlarmShutdown:           {48, 1, reg.RW, 0, 255, false, reg.None, 0, reg.EEPROM}, // Shutdown; bitfield; see docs
+
+		// RAM: Reset to default when power-cycled
+		reg.TorqueEnable:          {562, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.LedRed:                {563, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},
+		reg.LedGreen:              {564, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},
+		reg.LedBlue:               {565, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},
+		reg.VelocityIGain:         {586, 2, reg.RW, 0, 32767, false, reg.None, 0, reg.RAM},
+		reg.VelocityPGain:         {588, 2, reg.RW, 0, 32767, false, reg.None, 0, reg.RAM},
+		reg.PGain:                 {594, 2, reg.RW, 0, 32767, false, reg.None, 0, reg.RAM},      // Position P Gain
+		reg.GoalPosition:          {596, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.RAM}, // zero is center
+		reg.GoalVelocity:          {600, 4, reg.RW, -l.velocity, l.velocity, true, reg.None, 0, reg.RAM},
+		reg.GoalTorque:            {604, 2, reg.RW, -l.torque, l.torque, true, reg.None, 0, reg.RAM},
+		reg.GoalAcceleration:      {606, 4, reg.RW, 0, 2147483647, false, reg.None, 0, reg.RAM},
+		reg.Moving:                {610, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.PresentPosition:       {611, 4, reg.RO, x, x, true, reg.Degrees, deg, reg.RAM},
+		reg.PresentSpeed:          {615, 4, reg.RO, x, x, true, reg.None, 0, reg.RAM}, // Present Velocity
+		reg.PresentCurrent:        {621, 2, reg.RO, x, x, true, reg.None, 0, reg.RAM},
+		reg.PresentVoltage:        {623, 2, reg.RO, x, x, false, reg.Volts, 0.1, reg.RAM}, // Present Input Voltage
+		reg.PresentTemperature:    {625, 1, reg.RO, x, x, false, reg.Celsius, 1, reg.RAM},
+		reg.ExternalPortData1:     {626, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+		reg.ExternalPortData2:     {628, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+		reg.ExternalPortData3:     {630, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+		reg.ExternalPortData4:     {632, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+		reg.RegisteredInstruction: {890, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.StatusReturnLevel:     {891, 1, reg.RW, 0, 2, false, reg.None, 0, reg.RAM},
+		reg.HardwareErrorStatus:   {892, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+	}
+}
+
+func registersP(l limits) reg.Map {
+	x := 0
+	pos := l.position
+	deg := 180.0 / float64(pos)
+
+	return reg.Map{
+
+		// EEPROM: Persisted. Can only be written while torque is disabled.
+		reg.ModelNumber:             {0, 2, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ModelInformation:        {2, 4, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.FirmwareVersion:         {6, 1, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ServoID:                 {7, 1, reg.RW, 0, 252, false, reg.None, 0, reg.EEPROM},
+		reg.BaudRate:                {8, 1, reg.RW, 0, 6, false, reg.None, 0, reg.EEPROM}, // 0=9600, 1=57600, 2=115200, 3=1M, 4=2M, 5=3M, 6=4M
+		reg.ReturnDelayTime:         {9, 1, reg.RW, 0, 254, false, reg.Microseconds, 2, reg.EEPROM},
+		reg.DriveMode:               {10, 1, reg.RW, 0, 13, false, reg.None, 0, reg.EEPROM}, // bitfield; see docs
+		reg.OperatingMode:           {11, 1, reg.RW, 0, 4, false, reg.None, 0, reg.EEPROM},  // 0=current, 1=velocity, 3=position, 4=extended position
+		reg.SecondaryID:             {12, 1, reg.RW, 0, 255, false, reg.None, 0, reg.EEPROM},
+		reg.HomingOffset:            {20, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.EEPROM},
+		reg.MovingThreshold:         {24, 4, reg.RW, 0, l.velocity, false, reg.RPM, 0.01, reg.EEPROM},
+		reg.HighestLimitTemperature: {31, 1, reg.RW, 0, 80, false, reg.Celsius, 1, reg.EEPROM},
+		reg.HighestLimitVoltage:     {32, 2, reg.RW, 150, 350, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.LowestLimitVoltage:      {34, 2, reg.RW, 150, 350, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.PWMLimit:                {36, 2, reg.RW, 0, 2009, false, reg.Percent, 100.0 / 2009, reg.EEPROM},
+		reg.CurrentLimit:            {38, 2, reg.RW, 0, l.torque, false, reg.Milliamps, 1, reg.EEPROM},
+		reg.AccelerationLimit:       {40, 4, reg.RW, 0, 2147483647, false, reg.None, 0, reg.EEPROM},
+		reg.VelocityLimit:           {44, 4, reg.RW, 0, l.velocity, false, reg.RPM, 0.01, reg.EEPROM},
+		reg.CcwAngleLimit:           {48, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.EEPROM}, // Max Position Limit
+		reg.CwAngleLimit:            {52, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.EEPROM}, // Min Position Limit
+		reg.ExternalPortMode1:       {56, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.ExternalPortMode2:       {57, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.ExternalPortMode3:       {58, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.ExternalPortMode4:       {59, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},
+		reg.AlarmShutdown:           {63, 1, reg.RW, 0, 255, false, reg.None, 0, reg.EEPROM}, // Shutdown; bitfield; see docs
+
+		// RAM: Reset to default when power-cycled
+		reg.TorqueEnable:          {512, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.LedRed:                {513, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},
+		reg.LedGreen:              {514, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},
+		reg.LedBlue:               {515, 1, reg.RW, 0, 255, false, reg.None, 0, reg.RAM},
+		reg.StatusReturnLevel:     {516, 1, reg.RW, 0, 2, false, reg.None, 0, reg.RAM},
+		reg.RegisteredInstruction: {517, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.HardwareErrorStatus:   {518, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.VelocityIGain:         {524, 2, reg.RW, 0, 16367, false, reg.None, 0, reg.RAM},
+		reg.VelocityPGain:         {526, 2, reg.RW, 0, 16367, false, reg.None, 0, reg.RAM},
+		reg.DGain:                 {528, 2, reg.RW, 0, 16367, false, reg.None, 0, reg.RAM}, // Position D Gain
+		reg.IGain:                 {530, 2, reg.RW, 0, 16367, false, reg.None, 0, reg.RAM}, // Position I Gain
+		reg.PGain:                 {532, 2, reg.RW, 0, 16367, false, reg.None, 0, reg.RAM}, // Position P Gain
+		reg.Feedforward2ndGain:    {536, 2, reg.RW, 0, 16367, false, reg.None, 0, reg.RAM},
+		reg.Feedforward1stGain:    {538, 2, reg.RW, 0, 16367, false, reg.None, 0, reg.RAM},
+		reg.BusWatchdog:           {546, 1, reg.RW, 0, 127, false, reg.Milliseconds, 20, reg.RAM}, // 0 = disabled
+		reg.GoalPWM:               {548, 2, reg.RW, -2009, 2009, true, reg.Percent, 100.0 / 2009, reg.RAM},
+		reg.GoalCurrent:           {550, 2, reg.RW, -l.torque, l.torque, true, reg.Milliamps, 1, reg.RAM},
+		reg.GoalVelocity:          {552, 4, reg.RW, -l.velocity, l.velocity, true, reg.RPM, 0.01, reg.RAM},
+		reg.ProfileAcceleration:   {556, 4, reg.RW, 0, 2147483647, false, reg.None, 0, reg.RAM},
+		reg.ProfileVelocity:       {560, 4, reg.RW, 0, 2147483647, false, reg.RPM, 0.01, reg.RAM},
+		reg.GoalPosition:          {564, 4, reg.RW, -pos, pos, true, reg.Degrees, deg, reg.RAM}, // zero is center
+		reg.RealtimeTick:          {568, 2, reg.RO, x, x, false, reg.Milliseconds, 1, reg.RAM},
+		reg.Moving:                {570, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.MovingStatus:          {571, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.PresentPWM:            {572, 2, reg.RO, x, x, true, reg.Percent, 100.0 / 2009, reg.RAM},
+		reg.PresentCurrent:        {574, 2, reg.RO, x, x, true, reg.Milliamps, 1, reg.RAM},
+		reg.PresentSpeed:          {576, 4, reg.RO, x, x, true, reg.RPM, 0.01, reg.RAM}, // Present Velocity
+		reg.PresentPosition:       {580, 4, reg.RO, x, x, true, reg.Degrees, deg, reg.RAM},
+		reg.VelocityTrajectory:    {584, 4, reg.RO, x, x, true, reg.RPM, 0.01, reg.RAM},
+		reg.PositionTrajectory:    {588, 4, reg.RO, x, x, true, reg.Degrees, deg, reg.RAM},
+		reg.PresentVoltage:        {592, 2, reg.RO, x, x, false, reg.Volts, 0.1, reg.RAM}, // Present Input Voltage
+		reg.PresentTemperature:    {594, 1, reg.RO, x, x, false, reg.Celsius, 1, reg.RAM},
+		reg.ExternalPortData1:     {600, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+		reg.ExternalPortData2:     {602, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+		reg.ExternalPortData3:     {604, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+		reg.ExternalPortData4:     {606, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM},
+	}
+}
@@ -0,0 +1,965 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package pro
+
+import (
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+)
+
+// PROServo wraps a PRO-series servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type PROServo struct {
+	s *servo.Servo
+}
+
+// WrapPRO returns a PROServo wrapping the given servo.
+func WrapPRO(s *servo.Servo) *PROServo {
+	return &PROServo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *PROServo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *PROServo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *PROServo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *PROServo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *PROServo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *PROServo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *PROServo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *PROServo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *PROServo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *PROServo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *PROServo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *PROServo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *PROServo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *PROServo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *PROServo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *PROServo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *PROServo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *PROServo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *PROServo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *PROServo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *PROServo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *PROServo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *PROServo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *PROServo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *PROServo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// TorqueLimit returns the value of the TorqueLimit register.
+func (w *PROServo) TorqueLimit() (int, error) {
+	return w.s.TorqueLimit()
+}
+
+// SetTorqueLimit sets the value of the TorqueLimit register.
+func (w *PROServo) SetTorqueLimit(v int) error {
+	return w.s.SetTorqueLimit(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *PROServo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *PROServo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *PROServo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *PROServo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *PROServo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *PROServo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// PGain returns the value of the PGain register.
+func (w *PROServo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *PROServo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *PROServo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *PROServo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *PROServo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// GoalTorque returns the value of the GoalTorque register.
+func (w *PROServo) GoalTorque() (int, error) {
+	return w.s.GoalTorque()
+}
+
+// SetGoalTorque sets the value of the GoalTorque register.
+func (w *PROServo) SetGoalTorque(v int) error {
+	return w.s.SetGoalTorque(v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (w *PROServo) ModelInformation() (int, error) {
+	return w.s.ModelInformation()
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (w *PROServo) OperatingMode() (servo.OperatingMode, error) {
+	return w.s.OperatingMode()
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (w *PROServo) SetOperatingMode(v servo.OperatingMode) error {
+	return w.s.SetOperatingMode(v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (w *PROServo) HomingOffset() (int, error) {
+	return w.s.HomingOffset()
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (w *PROServo) SetHomingOffset(v int) error {
+	return w.s.SetHomingOffset(v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (w *PROServo) MovingThreshold() (int, error) {
+	return w.s.MovingThreshold()
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (w *PROServo) SetMovingThreshold(v int) error {
+	return w.s.SetMovingThreshold(v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (w *PROServo) VelocityLimit() (int, error) {
+	return w.s.VelocityLimit()
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (w *PROServo) SetVelocityLimit(v int) error {
+	return w.s.SetVelocityLimit(v)
+}
+
+// ExternalPortMode1 returns the value of the ExternalPortMode1 register.
+func (w *PROServo) ExternalPortMode1() (int, error) {
+	return w.s.ExternalPortMode1()
+}
+
+// SetExternalPortMode1 sets the value of the ExternalPortMode1 register.
+func (w *PROServo) SetExternalPortMode1(v int) error {
+	return w.s.SetExternalPortMode1(v)
+}
+
+// ExternalPortMode2 returns the value of the ExternalPortMode2 register.
+func (w *PROServo) ExternalPortMode2() (int, error) {
+	return w.s.ExternalPortMode2()
+}
+
+// SetExternalPortMode2 sets the value of the ExternalPortMode2 register.
+func (w *PROServo) SetExternalPortMode2(v int) error {
+	return w.s.SetExternalPortMode2(v)
+}
+
+// ExternalPortMode3 returns the value of the ExternalPortMode3 register.
+func (w *PROServo) ExternalPortMode3() (int, error) {
+	return w.s.ExternalPortMode3()
+}
+
+// SetExternalPortMode3 sets the value of the ExternalPortMode3 register.
+func (w *PROServo) SetExternalPortMode3(v int) error {
+	return w.s.SetExternalPortMode3(v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (w *PROServo) VelocityIGain() (int, error) {
+	return w.s.VelocityIGain()
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (w *PROServo) SetVelocityIGain(v int) error {
+	return w.s.SetVelocityIGain(v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (w *PROServo) VelocityPGain() (int, error) {
+	return w.s.VelocityPGain()
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (w *PROServo) SetVelocityPGain(v int) error {
+	return w.s.SetVelocityPGain(v)
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (w *PROServo) PresentCurrent() (int, error) {
+	return w.s.PresentCurrent()
+}
+
+// ExternalPortData1 returns the value of the ExternalPortData1 register.
+func (w *PROServo) ExternalPortData1() (int, error) {
+	return w.s.ExternalPortData1()
+}
+
+// SetExternalPortData1 sets the value of the ExternalPortData1 register.
+func (w *PROServo) SetExternalPortData1(v int) error {
+	return w.s.SetExternalPortData1(v)
+}
+
+// ExternalPortData2 returns the value of the ExternalPortData2 register.
+func (w *PROServo) ExternalPortData2() (int, error) {
+	return w.s.ExternalPortData2()
+}
+
+// SetExternalPortData2 sets the value of the ExternalPortData2 register.
+func (w *PROServo) SetExternalPortData2(v int) error {
+	return w.s.SetExternalPortData2(v)
+}
+
+// ExternalPortData3 returns the value of the ExternalPortData3 register.
+func (w *PROServo) ExternalPortData3() (int, error) {
+	return w.s.ExternalPortData3()
+}
+
+// SetExternalPortData3 sets the value of the ExternalPortData3 register.
+func (w *PROServo) SetExternalPortData3(v int) error {
+	return w.s.SetExternalPortData3(v)
+}
+
+// GoalAcceleration returns the value of the GoalAcceleration register.
+func (w *PROServo) GoalAcceleration() (int, error) {
+	return w.s.GoalAcceleration()
+}
+
+// SetGoalAcceleration sets the value of the GoalAcceleration register.
+func (w *PROServo) SetGoalAcceleration(v int) error {
+	return w.s.SetGoalAcceleration(v)
+}
+
+// AccelerationLimit returns the value of the AccelerationLimit register.
+func (w *PROServo) AccelerationLimit() (int, error) {
+	return w.s.AccelerationLimit()
+}
+
+// SetAccelerationLimit sets the value of the AccelerationLimit register.
+func (w *PROServo) SetAccelerationLimit(v int) error {
+	return w.s.SetAccelerationLimit(v)
+}
+
+// ExternalPortMode4 returns the value of the ExternalPortMode4 register.
+func (w *PROServo) ExternalPortMode4() (int, error) {
+	return w.s.ExternalPortMode4()
+}
+
+// SetExternalPortMode4 sets the value of the ExternalPortMode4 register.
+func (w *PROServo) SetExternalPortMode4(v int) error {
+	return w.s.SetExternalPortMode4(v)
+}
+
+// ExternalPortData4 returns the value of the ExternalPortData4 register.
+func (w *PROServo) ExternalPortData4() (int, error) {
+	return w.s.ExternalPortData4()
+}
+
+// SetExternalPortData4 sets the value of the ExternalPortData4 register.
+func (w *PROServo) SetExternalPortData4(v int) error {
+	return w.s.SetExternalPortData4(v)
+}
+
+// LEDRed returns the value of the LedRed register.
+func (w *PROServo) LEDRed() (int, error) {
+	return w.s.LEDRed()
+}
+
+// SetLEDRed sets the value of the LedRed register.
+func (w *PROServo) SetLEDRed(v int) error {
+	return w.s.SetLEDRed(v)
+}
+
+// LEDGreen returns the value of the LedGreen register.
+func (w *PROServo) LEDGreen() (int, error) {
+	return w.s.LEDGreen()
+}
+
+// SetLEDGreen sets the value of the LedGreen register.
+func (w *PROServo) SetLEDGreen(v int) error {
+	return w.s.SetLEDGreen(v)
+}
+
+// LEDBlue returns the value of the LedBlue register.
+func (w *PROServo) LEDBlue() (int, error) {
+	return w.s.LEDBlue()
+}
+
+// SetLEDBlue sets the value of the LedBlue register.
+func (w *PROServo) SetLEDBlue(v int) error {
+	return w.s.SetLEDBlue(v)
+}
+
+// PServo wraps a P-series servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type PServo struct {
+	s *servo.Servo
+}
+
+// WrapP returns a PServo wrapping the given servo.
+func WrapP(s *servo.Servo) *PServo {
+	return &PServo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *PServo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *PServo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *PServo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *PServo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *PServo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *PServo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *PServo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *PServo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *PServo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *PServo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *PServo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *PServo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *PServo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *PServo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *PServo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *PServo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *PServo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *PServo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *PServo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *PServo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *PServo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *PServo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *PServo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *PServo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *PServo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *PServo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *PServo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *PServo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *PServo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *PServo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *PServo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// DGain returns the value of the DGain register.
+func (w *PServo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *PServo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *PServo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *PServo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *PServo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *PServo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *PServo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *PServo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *PServo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (w *PServo) ModelInformation() (int, error) {
+	return w.s.ModelInformation()
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (w *PServo) DriveMode() (int, error) {
+	return w.s.DriveMode()
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (w *PServo) SetDriveMode(v int) error {
+	return w.s.SetDriveMode(v)
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (w *PServo) OperatingMode() (servo.OperatingMode, error) {
+	return w.s.OperatingMode()
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (w *PServo) SetOperatingMode(v servo.OperatingMode) error {
+	return w.s.SetOperatingMode(v)
+}
+
+// SecondaryID returns the value of the SecondaryID register.
+func (w *PServo) SecondaryID() (int, error) {
+	return w.s.SecondaryID()
+}
+
+// SetSecondaryID sets the value of the SecondaryID register.
+func (w *PServo) SetSecondaryID(v int) error {
+	return w.s.SetSecondaryID(v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (w *PServo) HomingOffset() (int, error) {
+	return w.s.HomingOffset()
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (w *PServo) SetHomingOffset(v int) error {
+	return w.s.SetHomingOffset(v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (w *PServo) MovingThreshold() (int, error) {
+	return w.s.MovingThreshold()
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (w *PServo) SetMovingThreshold(v int) error {
+	return w.s.SetMovingThreshold(v)
+}
+
+// PWMLimit returns the value of the PWMLimit register.
+func (w *PServo) PWMLimit() (int, error) {
+	return w.s.PWMLimit()
+}
+
+// SetPWMLimit sets the value of the PWMLimit register.
+func (w *PServo) SetPWMLimit(v int) error {
+	return w.s.SetPWMLimit(v)
+}
+
+// CurrentLimit returns the value of the CurrentLimit register.
+func (w *PServo) CurrentLimit() (int, error) {
+	return w.s.CurrentLimit()
+}
+
+// SetCurrentLimit sets the value of the CurrentLimit register.
+func (w *PServo) SetCurrentLimit(v int) error {
+	return w.s.SetCurrentLimit(v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (w *PServo) VelocityLimit() (int, error) {
+	return w.s.VelocityLimit()
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (w *PServo) SetVelocityLimit(v int) error {
+	return w.s.SetVelocityLimit(v)
+}
+
+// ExternalPortMode1 returns the value of the ExternalPortMode1 register.
+func (w *PServo) ExternalPortMode1() (int, error) {
+	return w.s.ExternalPortMode1()
+}
+
+// SetExternalPortMode1 sets the value of the ExternalPortMode1 register.
+func (w *PServo) SetExternalPortMode1(v int) error {
+	return w.s.SetExternalPortMode1(v)
+}
+
+// ExternalPortMode2 returns the value of the ExternalPortMode2 register.
+func (w *PServo) ExternalPortMode2() (int, error) {
+	return w.s.ExternalPortMode2()
+}
+
+// SetExternalPortMode2 sets the value of the ExternalPortMode2 register.
+func (w *PServo) SetExternalPortMode2(v int) error {
+	return w.s.SetExternalPortMode2(v)
+}
+
+// ExternalPortMode3 returns the value of the ExternalPortMode3 register.
+func (w *PServo) ExternalPortMode3() (int, error) {
+	return w.s.ExternalPortMode3()
+}
+
+// SetExternalPortMode3 sets the value of the ExternalPortMode3 register.
+func (w *PServo) SetExternalPortMode3(v int) error {
+	return w.s.SetExternalPortMode3(v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (w *PServo) VelocityIGain() (int, error) {
+	return w.s.VelocityIGain()
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (w *PServo) SetVelocityIGain(v int) error {
+	return w.s.SetVelocityIGain(v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (w *PServo) VelocityPGain() (int, error) {
+	return w.s.VelocityPGain()
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (w *PServo) SetVelocityPGain(v int) error {
+	return w.s.SetVelocityPGain(v)
+}
+
+// Feedforward2ndGain returns the value of the Feedforward2ndGain register.
+func (w *PServo) Feedforward2ndGain() (int, error) {
+	return w.s.Feedforward2ndGain()
+}
+
+// SetFeedforward2ndGain sets the value of the Feedforward2ndGain register.
+func (w *PServo) SetFeedforward2ndGain(v int) error {
+	return w.s.SetFeedforward2ndGain(v)
+}
+
+// Feedforward1stGain returns the value of the Feedforward1stGain register.
+func (w *PServo) Feedforward1stGain() (int, error) {
+	return w.s.Feedforward1stGain()
+}
+
+// SetFeedforward1stGain sets the value of the Feedforward1stGain register.
+func (w *PServo) SetFeedforward1stGain(v int) error {
+	return w.s.SetFeedforward1stGain(v)
+}
+
+// BusWatchdog returns the value of the BusWatchdog register.
+func (w *PServo) BusWatchdog() (int, error) {
+	return w.s.BusWatchdog()
+}
+
+// SetBusWatchdog sets the value of the BusWatchdog register.
+func (w *PServo) SetBusWatchdog(v int) error {
+	return w.s.SetBusWatchdog(v)
+}
+
+// GoalPWM returns the value of the GoalPWM register.
+func (w *PServo) GoalPWM() (int, error) {
+	return w.s.GoalPWM()
+}
+
+// SetGoalPWM sets the value of the GoalPWM register.
+func (w *PServo) SetGoalPWM(v int) error {
+	return w.s.SetGoalPWM(v)
+}
+
+// GoalCurrent returns the value of the GoalCurrent register.
+func (w *PServo) GoalCurrent() (int, error) {
+	return w.s.GoalCurrent()
+}
+
+// SetGoalCurrent sets the value of the GoalCurrent register.
+func (w *PServo) SetGoalCurrent(v int) error {
+	return w.s.SetGoalCurrent(v)
+}
+
+// ProfileAcceleration returns the value of the ProfileAcceleration register.
+func (w *PServo) ProfileAcceleration() (int, error) {
+	return w.s.ProfileAcceleration()
+}
+
+// SetProfileAcceleration sets the value of the ProfileAcceleration register.
+func (w *PServo) SetProfileAcceleration(v int) error {
+	return w.s.SetProfileAcceleration(v)
+}
+
+// ProfileVelocity returns the value of the ProfileVelocity register.
+func (w *PServo) ProfileVelocity() (int, error) {
+	return w.s.ProfileVelocity()
+}
+
+// SetProfileVelocity sets the value of the ProfileVelocity register.
+func (w *PServo) SetProfileVelocity(v int) error {
+	return w.s.SetProfileVelocity(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *PServo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// MovingStatus returns the value of the MovingStatus register.
+func (w *PServo) MovingStatus() (int, error) {
+	return w.s.MovingStatus()
+}
+
+// PresentPWM returns the value of the PresentPWM register.
+func (w *PServo) PresentPWM() (int, error) {
+	return w.s.PresentPWM()
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (w *PServo) PresentCurrent() (int, error) {
+	return w.s.PresentCurrent()
+}
+
+// VelocityTrajectory returns the value of the VelocityTrajectory register.
+func (w *PServo) VelocityTrajectory() (int, error) {
+	return w.s.VelocityTrajectory()
+}
+
+// PositionTrajectory returns the value of the PositionTrajectory register.
+func (w *PServo) PositionTrajectory() (int, error) {
+	return w.s.PositionTrajectory()
+}
+
+// ExternalPortData1 returns the value of the ExternalPortData1 register.
+func (w *PServo) ExternalPortData1() (int, error) {
+	return w.s.ExternalPortData1()
+}
+
+// SetExternalPortData1 sets the value of the ExternalPortData1 register.
+func (w *PServo) SetExternalPortData1(v int) error {
+	return w.s.SetExternalPortData1(v)
+}
+
+// ExternalPortData2 returns the value of the ExternalPortData2 register.
+func (w *PServo) ExternalPortData2() (int, error) {
+	return w.s.ExternalPortData2()
+}
+
+// SetExternalPortData2 sets the value of the ExternalPortData2 register.
+func (w *PServo) SetExternalPortData2(v int) error {
+	return w.s.SetExternalPortData2(v)
+}
+
+// ExternalPortData3 returns the value of the ExternalPortData3 register.
+func (w *PServo) ExternalPortData3() (int, error) {
+	return w.s.ExternalPortData3()
+}
+
+// SetExternalPortData3 sets the value of the ExternalPortData3 register.
+func (w *PServo) SetExternalPortData3(v int) error {
+	return w.s.SetExternalPortData3(v)
+}
+
+// AccelerationLimit returns the value of the AccelerationLimit register.
+func (w *PServo) AccelerationLimit() (int, error) {
+	return w.s.AccelerationLimit()
+}
+
+// SetAccelerationLimit sets the value of the AccelerationLimit register.
+func (w *PServo) SetAccelerationLimit(v int) error {
+	return w.s.SetAccelerationLimit(v)
+}
+
+// ExternalPortMode4 returns the value of the ExternalPortMode4 register.
+func (w *PServo) ExternalPortMode4() (int, error) {
+	return w.s.ExternalPortMode4()
+}
+
+// SetExternalPortMode4 sets the value of the ExternalPortMode4 register.
+func (w *PServo) SetExternalPortMode4(v int) error {
+	return w.s.SetExternalPortMode4(v)
+}
+
+// ExternalPortData4 returns the value of the ExternalPortData4 register.
+func (w *PServo) ExternalPortData4() (int, error) {
+	return w.s.ExternalPortData4()
+}
+
+// SetExternalPortData4 sets the value of the ExternalPortData4 register.
+func (w *PServo) SetExternalPortData4(v int) error {
+	return w.s.SetExternalPortData4(v)
+}
+
+// LEDRed returns the value of the LedRed register.
+func (w *PServo) LEDRed() (int, error) {
+	return w.s.LEDRed()
+}
+
+// SetLEDRed sets the value of the LedRed register.
+func (w *PServo) SetLEDRed(v int) error {
+	return w.s.SetLEDRed(v)
+}
+
+// LEDGreen returns the value of the LedGreen register.
+func (w *PServo) LEDGreen() (int, error) {
+	return w.s.LEDGreen()
+}
+
+// SetLEDGreen sets the value of the LedGreen register.
+func (w *PServo) SetLEDGreen(v int) error {
+	return w.s.SetLEDGreen(v)
+}
+
+// LEDBlue returns the value of the LedBlue register.
+func (w *PServo) LEDBlue() (int, error) {
+	return w.s.LEDBlue()
+}
+
+// SetLEDBlue sets the value of the LedBlue register.
+func (w *PServo) SetLEDBlue(v int) error {
+	return w.s.SetLEDBlue(v)
+}
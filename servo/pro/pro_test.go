@@ -0,0 +1,57 @@
+package pro
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisters(t *testing.T) {
+	all := []reg.Map{
+		H54200S500R, H54100S500R, H4220S300R, M5460S250R, M5440S250R, M4210S260R,
+		PH54200S500R, PH54100S500R, PH42020S300R, PM54060S250R, PM54040S250R, PM42010S260R,
+	}
+
+	for _, m := range all {
+		used := map[int]reg.RegName{}
+
+		for n, r := range m {
+			assert.Contains(t, []int{1, 2, 4}, r.Length, "%s", n)
+			assert.True(t, r.Min <= r.Max, "%s", n)
+
+			for i := 0; i < r.Length; i++ {
+				a := r.Address + i
+				other, ok := used[a]
+				assert.False(t, ok, "%s overlaps %s at %d", n, other, a)
+				used[a] = n
+			}
+		}
+	}
+}
+
+func TestGoalPosition(t *testing.T) {
+	r := H54200S500R[reg.GoalPosition]
+	assert.Equal(t, 596, r.Address)
+	assert.Equal(t, -250961, r.Min)
+
+	// With a return level of zero, the write is sent without waiting for a
+	// response, so it can be inspected.
+	b := &bytes.Buffer{}
+	s, err := NewWithReturnLevel(v2.New(b), H54200S500R, 1, 0)
+	assert.NoError(t, err)
+
+	err = s.SetGoalPosition(-250961)
+	assert.NoError(t, err)
+
+	pkt, _, err := v2.ParsePacket(b.Bytes())
+	if assert.NoError(t, err) {
+		assert.Equal(t, v2.WriteData, pkt.Instruction)
+		assert.Equal(t, []byte{0x54, 0x02, 0xaf, 0x2b, 0xfc, 0xff}, pkt.Params)
+	}
+
+	err = s.SetGoalPosition(250962)
+	assert.EqualError(t, err, "value too high: 250962 (max=250961)")
+}
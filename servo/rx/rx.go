@@ -0,0 +1,73 @@
+// Package rx provides the control tables of the RX-24F, RX-28 and RX-64. They
+// share the layout and resolution of the AX-12, but have wider ranges.
+//
+// See: http://support.robotis.com/en/product/dynamixel/rx_series/rx-28.htm
+package rx
+
+import (
+	"io"
+
+	"github.com/adammck/dynamixel/protocol/v1"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/ax"
+)
+
+// Control tables of each model. The comments are the model numbers.
+var (
+	RX24F reg.Map // 24
+	RX28  reg.Map // 28
+	RX64  reg.Map // 64
+)
+
+// NewRX24F returns a new RX-24F servo with the given ID.
+func NewRX24F(network io.ReadWriter, ID int) (*servo.Servo, error) {
+	return servo.New(v1.New(network), RX24F, ID), nil
+}
+
+// NewRX28 returns a new RX-28 servo with the given ID.
+func NewRX28(network io.ReadWriter, ID int) (*servo.Servo, error) {
+	return servo.New(v1.New(network), RX28, ID), nil
+}
+
+// NewRX64 returns a new RX-64 servo with the given ID.
+func NewRX64(network io.ReadWriter, ID int) (*servo.Servo, error) {
+	return servo.New(v1.New(network), RX64, ID), nil
+}
+
+// PositionToAngle converts a position (as in GoalPosition) to an angle in
+// degrees, where 0 is the center. This is the same as the AX.
+func PositionToAngle(pos int) float64 {
+	return ax.PositionToAngle(pos)
+}
+
+// AngleToPosition converts an angle in degrees, where 0 is the center, to the
+// nearest position. This is the same as the AX.
+func AngleToPosition(angle float64) int {
+	return ax.AngleToPosition(angle)
+}
+
+func init() {
+	RX24F = registers()
+	RX28 = registers()
+	RX64 = registers()
+
+	for _, m := range []struct {
+		name   string
+		number int
+		r      reg.Map
+	}{
+		{"RX-24F", 24, RX24F},
+		{"RX-28", 28, RX28},
+		{"RX-64", 64, RX64},
+	} {
+		servo.RegisterModel(&servo.Model{Name: m.name, Number: m.number, Protocol: 1, Registers: m.r, Steps: 1023, Range: 300, Center: 512})
+	}
+}
+
+func registers() reg.Map {
+	m := ax.Registers.Copy()
+	m[reg.HighestLimitTemperature].Max = 150 // docs says not to set
+	m[reg.Punch].Min = 0
+	return m
+}
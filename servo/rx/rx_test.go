@@ -0,0 +1,18 @@
+package rx
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisters(t *testing.T) {
+	assert.Equal(t, ax.Registers[reg.GoalPosition].Address, RX28[reg.GoalPosition].Address)
+	assert.Equal(t, 150, RX64[reg.HighestLimitTemperature].Max)
+	assert.Equal(t, 70, ax.Registers[reg.HighestLimitTemperature].Max)
+
+	// Each model has its own copy.
+	assert.False(t, RX24F[reg.Punch] == RX28[reg.Punch])
+}
@@ -1,3 +1,5 @@
+//go:generate go run gen.go
+
 package servo
 
 import (
@@ -9,15 +11,6 @@ import (
 	"github.com/adammck/dynamixel/utils"
 )
 
-const (
-
-	// Unit conversions
-	maxPos          uint16  = 1023
-	maxAngle        float64 = 300
-	positionToAngle float64 = maxAngle / float64(maxPos) // 0.293255132
-	angleToPosition float64 = 1 / positionToAngle        // 3.41
-)
-
 type Servo struct {
 	Protocol iface.Protocol
 	ID       int
@@ -35,8 +28,26 @@ type Servo struct {
 	// useful for synchronizing the movements of multiple servos.
 	buffered bool
 
-	// TODO: Remove this!
-	zeroAngle float64
+	// If true, torque is disabled while writing to EEPROM registers (and then
+	// re-enabled, if it was enabled). See SetTorqueSafe.
+	torqueSafe bool
+
+	// The number of writes to EEPROM registers which this servo has sent.
+	eepromWrites int
+
+	// The cached values of registers, or nil if caching is disabled. See
+	// SetCached.
+	cache *cache
+
+	// The model of the servo, if it's known. See Detect.
+	model *Model
+
+	// The CW and CCW angle limits from before the servo was put in wheel mode,
+	// so they can be restored. See SetMode.
+	angleLimits *[2]int
+
+	// The angle which Angle and MoveTo treat as zero. See SetOrigin.
+	origin Angle
 }
 
 // New returns a new Servo.
@@ -45,7 +56,7 @@ func New(proto iface.Protocol, registers reg.Map, ID int) *Servo {
 		Protocol:  proto,
 		ID:        ID,
 		registers: registers,
-		zeroAngle: 150,
+		model:     modelFor(registers),
 	}
 }
 
@@ -59,13 +70,44 @@ func NewWithReturnLevel(proto iface.Protocol, registers reg.Map, ID int, returnL
 	return s
 }
 
+// Model returns the model of the servo, or nil if it's unknown. It's known if
+// the servo was detected, or its control table is that of a registered model.
+func (s *Servo) Model() *Model {
+	return s.model
+}
+
+// alarmBits returns the bits which the alarm registers of the servo use.
+func (s *Servo) alarmBits() reg.AlarmBits {
+	if s.model == nil {
+		return reg.AlarmBits{}
+	}
+
+	return s.model.Alarms
+}
+
 // Enable instruction buffering, which causes register accessors to send the
 // REG_WRITE instruction instead of WRITE_DATA. This causes writes to be
 // buffered until the ACTION instruction is received (via Protocol.Action).
+// See Transaction, which is usually simpler.
 func (s *Servo) SetBuffered(buf bool) {
 	s.buffered = buf
 }
 
+// SetTorqueSafe enables torque-safe EEPROM writes. Some servos (e.g. the
+// X-series) refuse to write to EEPROM registers while torque is enabled, so
+// when this is enabled, writes to them first disable torque, then re-enable it
+// afterwards (if it was enabled). Buffered writes are not affected.
+func (s *Servo) SetTorqueSafe(safe bool) {
+	s.torqueSafe = safe
+}
+
+// EEPROMWrites returns the number of writes to EEPROM registers which have been
+// sent to the servo. EEPROM can only be written a limited number of times, so
+// this is useful to keep an eye on.
+func (s *Servo) EEPROMWrites() int {
+	return s.eepromWrites
+}
+
 // SetReturnLevel sets the return level. Possible values are:
 //
 //   0 = Only respond to PING commands
@@ -79,9 +121,9 @@ func (s *Servo) SetBuffered(buf bool) {
 //
 // See: dxl_ax_actuator.htm#Actuator_Address_10
 func (s *Servo) SetReturnLevel(value int) error {
-	reg := s.registers[reg.StatusReturnLevel]
+	r := s.registers[reg.StatusReturnLevel]
 
-	if value < reg.Min || value > reg.Max {
+	if value < r.Min || value > r.Max {
 		return fmt.Errorf("invalid Status Return Level value: %d", value)
 	}
 
@@ -89,7 +131,7 @@ func (s *Servo) SetReturnLevel(value int) error {
 	// return status level will depend upon the new level, rather than the
 	// current level. We don't want to update that until we're sure that the write
 	// was successful.
-	err := s.Protocol.WriteData(s.ID, int(reg.Address), []byte{utils.Low(value)}, (value == 2))
+	err := s.Protocol.WriteData(s.ID, r.Address, []byte{utils.Low(value)}, (value == 2))
 	if err != nil {
 		return err
 	}
@@ -98,6 +140,14 @@ func (s *Servo) SetReturnLevel(value int) error {
 	s.returnLevelKnown = true
 	s.returnLevelValue = value
 
+	if s.cache != nil {
+		s.cache.set(reg.StatusReturnLevel, r, value)
+	}
+
+	if r.Area == reg.EEPROM {
+		s.eepromWrites++
+	}
+
 	return nil
 }
 
@@ -124,7 +174,7 @@ func (s *Servo) FetchReturnLevel() error {
 	// one (return only for READ commands), or two (return for all commands).
 
 	r := s.registers[reg.StatusReturnLevel]
-	b, err := s.Protocol.ReadData(s.ID, int(r.Address), r.Length)
+	b, err := s.Protocol.ReadData(s.ID, r.Address, r.Length)
 	if err == nil {
 		s.returnLevelKnown = true
 		s.returnLevelValue = int(b[0])
@@ -154,29 +204,41 @@ func (s *Servo) getRegister(n reg.RegName) (int, error) {
 		return 0, fmt.Errorf("can't read unsupported register: %v", n)
 	}
 
-	if r.Length != 1 && r.Length != 2 {
+	if r.Length != 1 && r.Length != 2 && r.Length != 4 {
 		return 0, fmt.Errorf("invalid register length: %d", r.Length)
 	}
 
-	rl, err := s.ReturnLevel()
+	if s.cache != nil {
+		if v, ok := s.cache.get(n, r); ok {
+			return v, nil
+		}
+	}
+
+	b, err := s.readData(r.Address, r.Length)
 	if err != nil {
 		return 0, err
 	}
-	if rl == 0 {
-		return 0, errors.New("can't READ while Return Level is zero")
+
+	v, err := r.Decode(b)
+	if err == nil && s.cache != nil {
+		s.cache.set(n, r, v)
 	}
 
-	b, err := s.Protocol.ReadData(s.ID, int(r.Address), r.Length)
+	return v, err
+}
+
+// readData reads a slice of bytes from the control table, or returns an error
+// if the servo won't respond to READ.
+func (s *Servo) readData(address int, length int) ([]byte, error) {
+	rl, err := s.ReturnLevel()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-
-	if len(b) != r.Length {
-		return 0, fmt.Errorf("expected %d bytes, got %d", r.Length, len(b))
+	if rl == 0 {
+		return nil, errors.New("can't READ while Return Level is zero")
 	}
 
-	out, err := utils.BytesToInt(b)
-	return out, err
+	return s.Protocol.ReadData(s.ID, address, length)
 }
 
 // setRegister writes a value to the given register. Returns an error if the
@@ -201,16 +263,9 @@ func (s *Servo) setRegister(n reg.RegName, value int) error {
 
 	// Pass the appropriate number of params based on the register, not value.
 	// (We've already checked that the value is in range, above.)
-	var params []byte
-	switch r.Length {
-	case 1:
-		params = []byte{utils.Low(value)}
-
-	case 2:
-		params = []byte{utils.Low(value), utils.High(value)}
-
-	default:
-		return fmt.Errorf("invalid register length: %d", r.Length)
+	params, err := r.Encode(value)
+	if err != nil {
+		return err
 	}
 
 	// Refuse to write if we don't know the return level, because we can't know
@@ -230,11 +285,115 @@ func (s *Servo) setRegister(n reg.RegName, value int) error {
 	// TODO: If this is the only place we call RegWrite/WriteData, maybe
 	//       conditionally wait for the response here rather than in the proto.
 	//
+	// The value won't take effect until ACTION is sent (if ever), so forget
+	// the cached value rather than updating it. This also discards any write
+	// to the register which hasn't been flushed, since this one supersedes it.
 	if s.buffered {
-		return s.Protocol.RegWrite(s.ID, int(r.Address), params, expRes)
+		s.Invalidate(n)
+		return s.Protocol.RegWrite(s.ID, r.Address, params, expRes)
+	}
+
+	if s.cache != nil && s.cache.setDirty(n, r, value) {
+		return nil
+	}
+
+	if r.Area == reg.EEPROM {
+		err = s.writeEEPROM(n, r, value, params, expRes)
+	} else {
+		err = s.Protocol.WriteData(s.ID, r.Address, params, expRes)
+	}
+
+	if err == nil && s.cache != nil {
+		s.cache.set(n, r, value)
+	}
+
+	return err
+}
+
+// writeEEPROM writes to an EEPROM register, unless it already contains the
+// given value. If torque-safe writes are enabled, torque is disabled while
+// writing.
+func (s *Servo) writeEEPROM(n reg.RegName, r *reg.Register, value int, params []byte, expRes bool) error {
+
+	// Reading is much cheaper than wearing out the EEPROM. If the read fails
+	// (e.g. because the Return Level is zero), write anyway.
+	v, err := s.getRegister(n)
+	if err == nil && v == value {
+		return nil
+	}
+
+	return s.torqueSafely(func() error {
+		err := s.Protocol.WriteData(s.ID, r.Address, params, expRes)
+		if err != nil {
+			return err
+		}
+
+		s.eepromWrites++
+
+		// The servo will only respond to its new ID from now on, including when
+		// re-enabling the torque.
+		if n == reg.ServoID {
+			s.ID = value
+		}
+
+		return nil
+	})
+}
+
+// torqueSafely calls the given func, which writes to EEPROM. If torque-safe
+// writes are enabled, torque is disabled first, then re-enabled afterwards (if
+// it was enabled).
+func (s *Servo) torqueSafely(write func() error) error {
+	torque := false
+	if _, ok := s.registers[reg.TorqueEnable]; ok && s.torqueSafe {
+		var err error
+		torque, err = s.TorqueEnable()
+		if err != nil {
+			return err
+		}
+
+		if torque {
+			err = s.SetTorqueEnable(false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err := write()
+
+	if torque {
+		terr := s.SetTorqueEnable(true)
+		if err == nil {
+			err = terr
+		}
+	}
+
+	return err
+}
+
+// ReadPhysical returns the value of the given register in its physical unit
+// (e.g. volts rather than tenths of a volt), along with that unit. Registers
+// with no unit are returned as-is, with reg.None.
+func (s *Servo) ReadPhysical(n reg.RegName) (float64, reg.Unit, error) {
+	v, err := s.getRegister(n)
+	if err != nil {
+		return 0, reg.None, err
+	}
+
+	r := s.registers[n]
+	return r.ToPhysical(v), r.Unit, nil
+}
+
+// WritePhysical sets the value of the given register from a value in its
+// physical unit, rounded to the nearest step. See ReadPhysical.
+func (s *Servo) WritePhysical(n reg.RegName, f float64) error {
+	r, ok := s.registers[n]
+	if !ok {
+		return fmt.Errorf("can't write to unsupported register: %v", n)
 	}
 
-	return s.Protocol.WriteData(s.ID, int(r.Address), params, expRes)
+	return s.setRegister(n, r.FromPhysical(f))
 }
 
 // Ping sends the PING instruction to servo, and waits for the response. Returns
@@ -1,3 +1,5 @@
+// Code generated by gen.go; DO NOT EDIT.
+
 package servo
 
 import (
@@ -5,201 +7,202 @@ import (
 	"github.com/adammck/dynamixel/utils"
 )
 
-// These methods are getters for the various registers in the control table.
-//
-// TODO: Each of the following registers should have a corresponding reader, and
-//       the R/W registers (marked with an asterisk) should have a writer. They
-//       should all receive and return ints or bools, rather than bytes.
-//
-// TODO: These methods should probably be generated from the list of registers,
-//       especially if/when we support multiple models with different sets.
-//
-// modelNumber
-// firmwareVersion
-// servoID*
-// baudRate*
-// returnDelayTime*
-// cwAngleLimit*
-// ccwAngleLimit*
-// highestLimitTemperature*
-// lowestLimitVoltage*
-// highestLimitVoltage*
-// maxTorque*
-// statusReturnLevel*
-// alarmLed*
-// alarmShutdown*
-// torqueEnable*
-// led*
-// cwComplianceMargin*
-// ccwComplianceMargin*
-// cwComplianceSlope*
-// ccwComplianceSlope*
-// goalPosition*
-// movingSpeed*
-// torqueLimit*
-// presentPosition
-// presentSpeed
-// presentLoad
-// presentVoltage
-// presentTemperature
-// registeredInstruction (a.k.a. registered)
-// moving
-// lock*
-// punch*
-//
-
+// ModelNumber returns the value of the ModelNumber register.
 func (s *Servo) ModelNumber() (int, error) {
 	return s.getRegister(reg.ModelNumber)
 }
 
+// FirmwareVersion returns the value of the FirmwareVersion register.
 func (s *Servo) FirmwareVersion() (int, error) {
 	return s.getRegister(reg.FirmwareVersion)
 }
 
+// ServoID returns the value of the ServoID register.
 func (s *Servo) ServoID() (int, error) {
 	return s.getRegister(reg.ServoID)
 }
 
 // SetServoID changes the identity of the servo.
 // This is stored in EEPROM, so will persist between reboots.
-func (s *Servo) SetServoID(ident int) error {
-	return s.setRegister(reg.ServoID, ident)
+func (s *Servo) SetServoID(v int) error {
+	return s.setRegister(reg.ServoID, v)
 }
 
+// BaudRate returns the value of the BaudRate register.
 func (s *Servo) BaudRate() (int, error) {
 	return s.getRegister(reg.BaudRate)
 }
 
+// SetBaudRate sets the value of the BaudRate register.
 func (s *Servo) SetBaudRate(v int) error {
 	return s.setRegister(reg.BaudRate, v)
 }
 
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
 func (s *Servo) ReturnDelayTime() (int, error) {
 	return s.getRegister(reg.ReturnDelayTime)
 }
 
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
 func (s *Servo) SetReturnDelayTime(v int) error {
 	return s.setRegister(reg.ReturnDelayTime, v)
 }
 
+// CWAngleLimit returns the value of the CwAngleLimit register.
 func (s *Servo) CWAngleLimit() (int, error) {
 	return s.getRegister(reg.CwAngleLimit)
 }
 
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
 func (s *Servo) SetCWAngleLimit(v int) error {
 	return s.setRegister(reg.CwAngleLimit, v)
 }
 
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
 func (s *Servo) CCWAngleLimit() (int, error) {
 	return s.getRegister(reg.CcwAngleLimit)
 }
 
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
 func (s *Servo) SetCCWAngleLimit(v int) error {
 	return s.setRegister(reg.CcwAngleLimit, v)
 }
 
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
 func (s *Servo) HighestLimitTemperature() (int, error) {
 	return s.getRegister(reg.HighestLimitTemperature)
 }
 
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
 func (s *Servo) SetHighestLimitTemperature(v int) error {
 	return s.setRegister(reg.HighestLimitTemperature, v)
 }
 
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
 func (s *Servo) LowestLimitVoltage() (int, error) {
 	return s.getRegister(reg.LowestLimitVoltage)
 }
 
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
 func (s *Servo) SetLowestLimitVoltage(v int) error {
 	return s.setRegister(reg.LowestLimitVoltage, v)
 }
 
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
 func (s *Servo) HighestLimitVoltage() (int, error) {
 	return s.getRegister(reg.HighestLimitVoltage)
 }
 
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
 func (s *Servo) SetHighestLimitVoltage(v int) error {
 	return s.setRegister(reg.HighestLimitVoltage, v)
 }
 
+// MaxTorque returns the value of the MaxTorque register.
 func (s *Servo) MaxTorque() (int, error) {
 	return s.getRegister(reg.MaxTorque)
 }
 
+// SetMaxTorque sets the value of the MaxTorque register.
 func (s *Servo) SetMaxTorque(v int) error {
 	return s.setRegister(reg.MaxTorque, v)
 }
 
-func (s *Servo) AlarmLED() (int, error) {
-	return s.getRegister(reg.AlarmLed)
+// AlarmLED returns the value of the AlarmLed register.
+func (s *Servo) AlarmLED() (reg.AlarmFlags, error) {
+	v, err := s.getRegister(reg.AlarmLed)
+	return s.alarmBits().Decode(v), err
 }
 
-func (s *Servo) SetAlarmLED(v int) error {
+// SetAlarmLED sets the value of the AlarmLed register.
+func (s *Servo) SetAlarmLED(f reg.AlarmFlags) error {
+	v, err := s.alarmBits().Encode(f)
+	if err != nil {
+		return err
+	}
+
 	return s.setRegister(reg.AlarmLed, v)
 }
 
-func (s *Servo) AlarmShutdown() (int, error) {
-	return s.getRegister(reg.AlarmShutdown)
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (s *Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	v, err := s.getRegister(reg.AlarmShutdown)
+	return s.alarmBits().Decode(v), err
 }
 
-func (s *Servo) SetAlarmShutdown(v int) error {
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (s *Servo) SetAlarmShutdown(f reg.AlarmFlags) error {
+	v, err := s.alarmBits().Encode(f)
+	if err != nil {
+		return err
+	}
+
 	return s.setRegister(reg.AlarmShutdown, v)
 }
 
+// TorqueEnable returns the value of the TorqueEnable register.
 func (s *Servo) TorqueEnable() (bool, error) {
 	v, err := s.getRegister(reg.TorqueEnable)
 	return utils.IntToBool(v), err
 }
 
-// SetTorqueEnable enables or disables torque.
-func (s *Servo) SetTorqueEnable(state bool) error {
-	return s.setRegister(reg.TorqueEnable, utils.BoolToInt(state))
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (s *Servo) SetTorqueEnable(v bool) error {
+	return s.setRegister(reg.TorqueEnable, utils.BoolToInt(v))
 }
 
-// LED returns the current state of the servo's LED.
-// TODO: Should we continue to return bool here, or expose the int?
+// LED returns the value of the Led register.
 func (s *Servo) LED() (bool, error) {
 	v, err := s.getRegister(reg.Led)
 	return utils.IntToBool(v), err
 }
 
-// Enables or disables the servo's LED.
-func (s *Servo) SetLED(state bool) error {
-	return s.setRegister(reg.Led, utils.BoolToInt(state))
+// SetLED sets the value of the Led register.
+func (s *Servo) SetLED(v bool) error {
+	return s.setRegister(reg.Led, utils.BoolToInt(v))
 }
 
+// CWComplianceMargin returns the value of the CwComplianceMargin register.
 func (s *Servo) CWComplianceMargin() (int, error) {
 	return s.getRegister(reg.CwComplianceMargin)
 }
 
+// SetCWComplianceMargin sets the value of the CwComplianceMargin register.
 func (s *Servo) SetCWComplianceMargin(v int) error {
 	return s.setRegister(reg.CwComplianceMargin, v)
 }
 
+// CCWComplianceMargin returns the value of the CcwComplianceMargin register.
 func (s *Servo) CCWComplianceMargin() (int, error) {
 	return s.getRegister(reg.CcwComplianceMargin)
 }
 
-func (s *Servo) SetCCWComplianceMarginval(v int) error {
+// SetCCWComplianceMargin sets the value of the CcwComplianceMargin register.
+func (s *Servo) SetCCWComplianceMargin(v int) error {
 	return s.setRegister(reg.CcwComplianceMargin, v)
 }
 
+// CWComplianceSlope returns the value of the CwComplianceSlope register.
 func (s *Servo) CWComplianceSlope() (int, error) {
 	return s.getRegister(reg.CwComplianceSlope)
 }
 
+// SetCWComplianceSlope sets the value of the CwComplianceSlope register.
 func (s *Servo) SetCWComplianceSlope(v int) error {
 	return s.setRegister(reg.CwComplianceSlope, v)
 }
 
+// CCWComplianceSlope returns the value of the CcwComplianceSlope register.
 func (s *Servo) CCWComplianceSlope() (int, error) {
 	return s.getRegister(reg.CcwComplianceSlope)
 }
 
+// SetCCWComplianceSlope sets the value of the CcwComplianceSlope register.
 func (s *Servo) SetCCWComplianceSlope(v int) error {
 	return s.setRegister(reg.CcwComplianceSlope, v)
 }
 
+// GoalPosition returns the value of the GoalPosition register.
 func (s *Servo) GoalPosition() (int, error) {
 	return s.getRegister(reg.GoalPosition)
 }
@@ -207,10 +210,10 @@ func (s *Servo) GoalPosition() (int, error) {
 // SetGoalPosition sets the goal position.
 //
 // TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
-//       is zero).
 //
-func (s *Servo) SetGoalPosition(pos int) error {
-	return s.setRegister(reg.GoalPosition, pos)
+//	is zero).
+func (s *Servo) SetGoalPosition(v int) error {
+	return s.setRegister(reg.GoalPosition, v)
 }
 
 // MovingSpeed returns the current moving speed. This is not the speed at which
@@ -222,53 +225,525 @@ func (s *Servo) MovingSpeed() (int, error) {
 // SetMovingSpeed the moving speed.
 //
 // Note: Setting the moving speed appears to reset the TorqueEnabled register to
-//       true, at least on my AX12s.
 //
-func (s *Servo) SetMovingSpeed(speed int) error {
-	return s.setRegister(reg.MovingSpeed, speed)
+//	true, at least on my AX12s.
+func (s *Servo) SetMovingSpeed(v int) error {
+	return s.setRegister(reg.MovingSpeed, v)
 }
 
+// TorqueLimit returns the value of the TorqueLimit register.
 func (s *Servo) TorqueLimit() (int, error) {
 	return s.getRegister(reg.TorqueLimit)
 }
 
-func (s *Servo) SetTorqueLimit(val int) error {
-	return s.setRegister(reg.TorqueLimit, val)
+// SetTorqueLimit sets the value of the TorqueLimit register.
+func (s *Servo) SetTorqueLimit(v int) error {
+	return s.setRegister(reg.TorqueLimit, v)
 }
 
+// PresentPosition returns the value of the PresentPosition register.
 func (s *Servo) PresentPosition() (int, error) {
 	return s.getRegister(reg.PresentPosition)
 }
 
+// PresentSpeed returns the value of the PresentSpeed register.
 func (s *Servo) PresentSpeed() (int, error) {
 	return s.getRegister(reg.PresentSpeed)
 }
 
-func (s *Servo) PresentVoltage() (int, error) {
-	return s.getRegister(reg.PresentVoltage)
-}
-
+// PresentLoad returns the value of the PresentLoad register.
 func (s *Servo) PresentLoad() (int, error) {
 	return s.getRegister(reg.PresentLoad)
 }
 
+// PresentVoltage returns the value of the PresentVoltage register.
+func (s *Servo) PresentVoltage() (int, error) {
+	return s.getRegister(reg.PresentVoltage)
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
 func (s *Servo) PresentTemperature() (int, error) {
 	return s.getRegister(reg.PresentTemperature)
 }
 
-func (s *Servo) RegisteredInstruction() (int, error) {
-	return s.getRegister(reg.RegisteredInstruction)
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (s *Servo) RegisteredInstruction() (bool, error) {
+	v, err := s.getRegister(reg.RegisteredInstruction)
+	return utils.IntToBool(v), err
+}
+
+// Moving returns the value of the Moving register.
+func (s *Servo) Moving() (bool, error) {
+	v, err := s.getRegister(reg.Moving)
+	return utils.IntToBool(v), err
+}
+
+// Lock returns the value of the Lock register.
+func (s *Servo) Lock() (bool, error) {
+	v, err := s.getRegister(reg.Lock)
+	return utils.IntToBool(v), err
+}
+
+// SetLock sets the value of the Lock register.
+func (s *Servo) SetLock(v bool) error {
+	return s.setRegister(reg.Lock, utils.BoolToInt(v))
+}
+
+// Punch returns the value of the Punch register.
+func (s *Servo) Punch() (int, error) {
+	return s.getRegister(reg.Punch)
+}
+
+// SetPunch sets the value of the Punch register.
+func (s *Servo) SetPunch(v int) error {
+	return s.setRegister(reg.Punch, v)
+}
+
+// ControlMode returns the value of the ControlMode register.
+func (s *Servo) ControlMode() (ControlMode, error) {
+	v, err := s.getRegister(reg.ControlMode)
+	return ControlMode(v), err
+}
+
+// SetControlMode sets the value of the ControlMode register.
+func (s *Servo) SetControlMode(v ControlMode) error {
+	return s.setRegister(reg.ControlMode, int(v))
+}
+
+// DGain returns the value of the DGain register.
+func (s *Servo) DGain() (int, error) {
+	return s.getRegister(reg.DGain)
+}
+
+// SetDGain sets the value of the DGain register.
+func (s *Servo) SetDGain(v int) error {
+	return s.setRegister(reg.DGain, v)
+}
+
+// IGain returns the value of the IGain register.
+func (s *Servo) IGain() (int, error) {
+	return s.getRegister(reg.IGain)
+}
+
+// SetIGain sets the value of the IGain register.
+func (s *Servo) SetIGain(v int) error {
+	return s.setRegister(reg.IGain, v)
+}
+
+// PGain returns the value of the PGain register.
+func (s *Servo) PGain() (int, error) {
+	return s.getRegister(reg.PGain)
+}
+
+// SetPGain sets the value of the PGain register.
+func (s *Servo) SetPGain(v int) error {
+	return s.setRegister(reg.PGain, v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (s *Servo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	v, err := s.getRegister(reg.HardwareErrorStatus)
+	return s.alarmBits().Decode(v), err
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (s *Servo) GoalVelocity() (int, error) {
+	return s.getRegister(reg.GoalVelocity)
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (s *Servo) SetGoalVelocity(v int) error {
+	return s.setRegister(reg.GoalVelocity, v)
+}
+
+// GoalTorque returns the value of the GoalTorque register.
+func (s *Servo) GoalTorque() (int, error) {
+	return s.getRegister(reg.GoalTorque)
+}
+
+// SetGoalTorque sets the value of the GoalTorque register.
+func (s *Servo) SetGoalTorque(v int) error {
+	return s.setRegister(reg.GoalTorque, v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (s *Servo) ModelInformation() (int, error) {
+	return s.getRegister(reg.ModelInformation)
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (s *Servo) DriveMode() (int, error) {
+	return s.getRegister(reg.DriveMode)
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (s *Servo) SetDriveMode(v int) error {
+	return s.setRegister(reg.DriveMode, v)
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (s *Servo) OperatingMode() (OperatingMode, error) {
+	v, err := s.getRegister(reg.OperatingMode)
+	return OperatingMode(v), err
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (s *Servo) SetOperatingMode(v OperatingMode) error {
+	return s.setRegister(reg.OperatingMode, int(v))
+}
+
+// SecondaryID returns the value of the SecondaryID register.
+func (s *Servo) SecondaryID() (int, error) {
+	return s.getRegister(reg.SecondaryID)
+}
+
+// SetSecondaryID sets the value of the SecondaryID register.
+func (s *Servo) SetSecondaryID(v int) error {
+	return s.setRegister(reg.SecondaryID, v)
+}
+
+// ProtocolType returns the value of the ProtocolType register.
+func (s *Servo) ProtocolType() (int, error) {
+	return s.getRegister(reg.ProtocolType)
+}
+
+// SetProtocolType sets the value of the ProtocolType register.
+func (s *Servo) SetProtocolType(v int) error {
+	return s.setRegister(reg.ProtocolType, v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (s *Servo) HomingOffset() (int, error) {
+	return s.getRegister(reg.HomingOffset)
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (s *Servo) SetHomingOffset(v int) error {
+	return s.setRegister(reg.HomingOffset, v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (s *Servo) MovingThreshold() (int, error) {
+	return s.getRegister(reg.MovingThreshold)
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (s *Servo) SetMovingThreshold(v int) error {
+	return s.setRegister(reg.MovingThreshold, v)
+}
+
+// PWMLimit returns the value of the PWMLimit register.
+func (s *Servo) PWMLimit() (int, error) {
+	return s.getRegister(reg.PWMLimit)
+}
+
+// SetPWMLimit sets the value of the PWMLimit register.
+func (s *Servo) SetPWMLimit(v int) error {
+	return s.setRegister(reg.PWMLimit, v)
+}
+
+// CurrentLimit returns the value of the CurrentLimit register.
+func (s *Servo) CurrentLimit() (int, error) {
+	return s.getRegister(reg.CurrentLimit)
+}
+
+// SetCurrentLimit sets the value of the CurrentLimit register.
+func (s *Servo) SetCurrentLimit(v int) error {
+	return s.setRegister(reg.CurrentLimit, v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (s *Servo) VelocityLimit() (int, error) {
+	return s.getRegister(reg.VelocityLimit)
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (s *Servo) SetVelocityLimit(v int) error {
+	return s.setRegister(reg.VelocityLimit, v)
+}
+
+// ExternalPortMode1 returns the value of the ExternalPortMode1 register.
+func (s *Servo) ExternalPortMode1() (int, error) {
+	return s.getRegister(reg.ExternalPortMode1)
+}
+
+// SetExternalPortMode1 sets the value of the ExternalPortMode1 register.
+func (s *Servo) SetExternalPortMode1(v int) error {
+	return s.setRegister(reg.ExternalPortMode1, v)
+}
+
+// ExternalPortMode2 returns the value of the ExternalPortMode2 register.
+func (s *Servo) ExternalPortMode2() (int, error) {
+	return s.getRegister(reg.ExternalPortMode2)
+}
+
+// SetExternalPortMode2 sets the value of the ExternalPortMode2 register.
+func (s *Servo) SetExternalPortMode2(v int) error {
+	return s.setRegister(reg.ExternalPortMode2, v)
+}
+
+// ExternalPortMode3 returns the value of the ExternalPortMode3 register.
+func (s *Servo) ExternalPortMode3() (int, error) {
+	return s.getRegister(reg.ExternalPortMode3)
+}
+
+// SetExternalPortMode3 sets the value of the ExternalPortMode3 register.
+func (s *Servo) SetExternalPortMode3(v int) error {
+	return s.setRegister(reg.ExternalPortMode3, v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (s *Servo) VelocityIGain() (int, error) {
+	return s.getRegister(reg.VelocityIGain)
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (s *Servo) SetVelocityIGain(v int) error {
+	return s.setRegister(reg.VelocityIGain, v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (s *Servo) VelocityPGain() (int, error) {
+	return s.getRegister(reg.VelocityPGain)
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (s *Servo) SetVelocityPGain(v int) error {
+	return s.setRegister(reg.VelocityPGain, v)
+}
+
+// Feedforward2ndGain returns the value of the Feedforward2ndGain register.
+func (s *Servo) Feedforward2ndGain() (int, error) {
+	return s.getRegister(reg.Feedforward2ndGain)
+}
+
+// SetFeedforward2ndGain sets the value of the Feedforward2ndGain register.
+func (s *Servo) SetFeedforward2ndGain(v int) error {
+	return s.setRegister(reg.Feedforward2ndGain, v)
+}
+
+// Feedforward1stGain returns the value of the Feedforward1stGain register.
+func (s *Servo) Feedforward1stGain() (int, error) {
+	return s.getRegister(reg.Feedforward1stGain)
+}
+
+// SetFeedforward1stGain sets the value of the Feedforward1stGain register.
+func (s *Servo) SetFeedforward1stGain(v int) error {
+	return s.setRegister(reg.Feedforward1stGain, v)
+}
+
+// BusWatchdog returns the value of the BusWatchdog register.
+func (s *Servo) BusWatchdog() (int, error) {
+	return s.getRegister(reg.BusWatchdog)
+}
+
+// SetBusWatchdog sets the value of the BusWatchdog register.
+func (s *Servo) SetBusWatchdog(v int) error {
+	return s.setRegister(reg.BusWatchdog, v)
+}
+
+// GoalPWM returns the value of the GoalPWM register.
+func (s *Servo) GoalPWM() (int, error) {
+	return s.getRegister(reg.GoalPWM)
+}
+
+// SetGoalPWM sets the value of the GoalPWM register.
+func (s *Servo) SetGoalPWM(v int) error {
+	return s.setRegister(reg.GoalPWM, v)
+}
+
+// GoalCurrent returns the value of the GoalCurrent register.
+func (s *Servo) GoalCurrent() (int, error) {
+	return s.getRegister(reg.GoalCurrent)
+}
+
+// SetGoalCurrent sets the value of the GoalCurrent register.
+func (s *Servo) SetGoalCurrent(v int) error {
+	return s.setRegister(reg.GoalCurrent, v)
+}
+
+// ProfileAcceleration returns the value of the ProfileAcceleration register.
+func (s *Servo) ProfileAcceleration() (int, error) {
+	return s.getRegister(reg.ProfileAcceleration)
+}
+
+// SetProfileAcceleration sets the value of the ProfileAcceleration register.
+func (s *Servo) SetProfileAcceleration(v int) error {
+	return s.setRegister(reg.ProfileAcceleration, v)
+}
+
+// ProfileVelocity returns the value of the ProfileVelocity register.
+func (s *Servo) ProfileVelocity() (int, error) {
+	return s.getRegister(reg.ProfileVelocity)
+}
+
+// SetProfileVelocity sets the value of the ProfileVelocity register.
+func (s *Servo) SetProfileVelocity(v int) error {
+	return s.setRegister(reg.ProfileVelocity, v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (s *Servo) RealtimeTick() (int, error) {
+	return s.getRegister(reg.RealtimeTick)
+}
+
+// MovingStatus returns the value of the MovingStatus register.
+func (s *Servo) MovingStatus() (int, error) {
+	return s.getRegister(reg.MovingStatus)
+}
+
+// PresentPWM returns the value of the PresentPWM register.
+func (s *Servo) PresentPWM() (int, error) {
+	return s.getRegister(reg.PresentPWM)
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (s *Servo) PresentCurrent() (int, error) {
+	return s.getRegister(reg.PresentCurrent)
+}
+
+// VelocityTrajectory returns the value of the VelocityTrajectory register.
+func (s *Servo) VelocityTrajectory() (int, error) {
+	return s.getRegister(reg.VelocityTrajectory)
+}
+
+// PositionTrajectory returns the value of the PositionTrajectory register.
+func (s *Servo) PositionTrajectory() (int, error) {
+	return s.getRegister(reg.PositionTrajectory)
+}
+
+// ExternalPortData1 returns the value of the ExternalPortData1 register.
+func (s *Servo) ExternalPortData1() (int, error) {
+	return s.getRegister(reg.ExternalPortData1)
+}
+
+// SetExternalPortData1 sets the value of the ExternalPortData1 register.
+func (s *Servo) SetExternalPortData1(v int) error {
+	return s.setRegister(reg.ExternalPortData1, v)
+}
+
+// ExternalPortData2 returns the value of the ExternalPortData2 register.
+func (s *Servo) ExternalPortData2() (int, error) {
+	return s.getRegister(reg.ExternalPortData2)
+}
+
+// SetExternalPortData2 sets the value of the ExternalPortData2 register.
+func (s *Servo) SetExternalPortData2(v int) error {
+	return s.setRegister(reg.ExternalPortData2, v)
+}
+
+// ExternalPortData3 returns the value of the ExternalPortData3 register.
+func (s *Servo) ExternalPortData3() (int, error) {
+	return s.getRegister(reg.ExternalPortData3)
+}
+
+// SetExternalPortData3 sets the value of the ExternalPortData3 register.
+func (s *Servo) SetExternalPortData3(v int) error {
+	return s.setRegister(reg.ExternalPortData3, v)
+}
+
+// MultiTurnOffset returns the value of the MultiTurnOffset register.
+func (s *Servo) MultiTurnOffset() (int, error) {
+	return s.getRegister(reg.MultiTurnOffset)
+}
+
+// SetMultiTurnOffset sets the value of the MultiTurnOffset register.
+func (s *Servo) SetMultiTurnOffset(v int) error {
+	return s.setRegister(reg.MultiTurnOffset, v)
+}
+
+// ResolutionDivider returns the value of the ResolutionDivider register.
+func (s *Servo) ResolutionDivider() (int, error) {
+	return s.getRegister(reg.ResolutionDivider)
+}
+
+// SetResolutionDivider sets the value of the ResolutionDivider register.
+func (s *Servo) SetResolutionDivider(v int) error {
+	return s.setRegister(reg.ResolutionDivider, v)
+}
+
+// GoalAcceleration returns the value of the GoalAcceleration register.
+func (s *Servo) GoalAcceleration() (int, error) {
+	return s.getRegister(reg.GoalAcceleration)
+}
+
+// SetGoalAcceleration sets the value of the GoalAcceleration register.
+func (s *Servo) SetGoalAcceleration(v int) error {
+	return s.setRegister(reg.GoalAcceleration, v)
+}
+
+// TorqueControlModeEnable returns the value of the TorqueControlModeEnable register.
+func (s *Servo) TorqueControlModeEnable() (bool, error) {
+	v, err := s.getRegister(reg.TorqueControlModeEnable)
+	return utils.IntToBool(v), err
+}
+
+// SetTorqueControlModeEnable sets the value of the TorqueControlModeEnable register.
+func (s *Servo) SetTorqueControlModeEnable(v bool) error {
+	return s.setRegister(reg.TorqueControlModeEnable, utils.BoolToInt(v))
+}
+
+// SensedCurrent returns the value of the SensedCurrent register.
+func (s *Servo) SensedCurrent() (int, error) {
+	return s.getRegister(reg.SensedCurrent)
+}
+
+// AccelerationLimit returns the value of the AccelerationLimit register.
+func (s *Servo) AccelerationLimit() (int, error) {
+	return s.getRegister(reg.AccelerationLimit)
+}
+
+// SetAccelerationLimit sets the value of the AccelerationLimit register.
+func (s *Servo) SetAccelerationLimit(v int) error {
+	return s.setRegister(reg.AccelerationLimit, v)
+}
+
+// ExternalPortMode4 returns the value of the ExternalPortMode4 register.
+func (s *Servo) ExternalPortMode4() (int, error) {
+	return s.getRegister(reg.ExternalPortMode4)
+}
+
+// SetExternalPortMode4 sets the value of the ExternalPortMode4 register.
+func (s *Servo) SetExternalPortMode4(v int) error {
+	return s.setRegister(reg.ExternalPortMode4, v)
+}
+
+// ExternalPortData4 returns the value of the ExternalPortData4 register.
+func (s *Servo) ExternalPortData4() (int, error) {
+	return s.getRegister(reg.ExternalPortData4)
+}
+
+// SetExternalPortData4 sets the value of the ExternalPortData4 register.
+func (s *Servo) SetExternalPortData4(v int) error {
+	return s.setRegister(reg.ExternalPortData4, v)
+}
+
+// LEDRed returns the value of the LedRed register.
+func (s *Servo) LEDRed() (int, error) {
+	return s.getRegister(reg.LedRed)
+}
+
+// SetLEDRed sets the value of the LedRed register.
+func (s *Servo) SetLEDRed(v int) error {
+	return s.setRegister(reg.LedRed, v)
+}
+
+// LEDGreen returns the value of the LedGreen register.
+func (s *Servo) LEDGreen() (int, error) {
+	return s.getRegister(reg.LedGreen)
 }
 
-func (s *Servo) Moving() (int, error) {
-	return s.getRegister(reg.Moving)
+// SetLEDGreen sets the value of the LedGreen register.
+func (s *Servo) SetLEDGreen(v int) error {
+	return s.setRegister(reg.LedGreen, v)
 }
 
-// TODO: Rename this to avoid confusion?
-func (s *Servo) Lock() (int, error) {
-	return s.getRegister(reg.Lock)
+// LEDBlue returns the value of the LedBlue register.
+func (s *Servo) LEDBlue() (int, error) {
+	return s.getRegister(reg.LedBlue)
 }
 
-func (s *Servo) SetLock(isLocked int) error {
-	return s.setRegister(reg.Lock, isLocked)
+// SetLEDBlue sets the value of the LedBlue register.
+func (s *Servo) SetLEDBlue(v int) error {
+	return s.setRegister(reg.LedBlue, v)
 }
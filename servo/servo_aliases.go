@@ -14,7 +14,7 @@ func (s *Servo) Position() (int, error) {
 
 // Registered is a legacy alias for RegisteredInstruction. The XL docs use the
 // longer name, which seems clearer to me.
-func (s *Servo) Registered() (int, error) {
+func (s *Servo) Registered() (bool, error) {
 	return s.RegisteredInstruction()
 }
 
@@ -29,3 +29,9 @@ func (s *Servo) LimitTemperature() (int, error) {
 func (s *Servo) SetLimitTemperature(v int) error {
 	return s.SetHighestLimitTemperature(v)
 }
+
+// SetCCWComplianceMarginval is a legacy alias for SetCCWComplianceMargin, which
+// was misnamed.
+func (s *Servo) SetCCWComplianceMarginval(v int) error {
+	return s.SetCCWComplianceMargin(v)
+}
@@ -1,62 +1,135 @@
 package servo
 
+import (
+	"errors"
+	"fmt"
+
+	reg "github.com/adammck/dynamixel/registers"
+)
+
 // High-level interface. (Most of this should be removed, or moved to a separate
 // type which embeds or interacts with the servo type.)
 
-func (s *Servo) posToAngle(p int) float64 {
-	return (positionToAngle * float64(p)) - s.zeroAngle
+// SetOrigin sets the angle which Angle and MoveTo treat as zero, relative to
+// the center of the servo. This is useful to calibrate servos which aren't
+// mounted quite straight. The default is zero, i.e. the center.
+func (s *Servo) SetOrigin(a Angle) {
+	s.origin = a
 }
 
-func (s *Servo) angleToPos(angle float64) int {
-	return int((s.zeroAngle + angle) * angleToPosition)
-}
-
-// Sets the origin angle (in degrees).
+// SetZero sets the origin angle (in degrees), relative to position zero rather
+// than the center of the servo. Since that depends on the model, this does
+// nothing if the model of the servo isn't known (in which case Angle and MoveTo
+// return an error anyway).
+//
+// Deprecated: Use SetOrigin.
 func (s *Servo) SetZero(offset float64) {
-	s.zeroAngle = offset
+	if s.model != nil {
+		s.origin = Degrees(offset + s.model.PositionToAngle(0))
+	}
 }
 
-// Returns the current position of the servo, relative to the zero angle.
-func (s *Servo) Angle() (float64, error) {
-	p, err := s.Position()
+// Angle returns the current angle of the servo, relative to its origin (see
+// SetOrigin). The conversion depends on the model, which must be known.
+func (s *Servo) Angle() (Angle, error) {
+	if s.model == nil {
+		return 0, errors.New("can't convert position of servo of unknown model")
+	}
 
+	p, err := s.Position()
 	if err != nil {
 		return 0, err
 	}
 
-	return s.posToAngle(p), nil
+	return Degrees(s.model.PositionToAngle(p)) - s.origin, nil
 }
 
-// MoveTo sets the goal position of the servo by angle (in degrees), where zero
-// is the midpoint, 150 deg is max left (clockwise), and -150 deg is max right
-// (counter-clockwise). This is generally preferable to calling SetGoalPosition,
-// which uses the internal uint16 representation.
-func (s *Servo) MoveTo(angle float64) error {
-	p := s.angleToPos(normalizeAngle(angle))
-	return s.SetGoalPosition(p)
-}
+// MoveTo sets the goal position of the servo by angle, relative to its origin
+// (see SetOrigin). Positive angles are counter-clockwise. This is generally
+// preferable to calling SetGoalPosition, which uses the internal representation
+// of the model, which must be known.
+//
+// Angles beyond the CW or CCW angle limits of the servo are clamped to them,
+// rather than wrapped around. In multi-turn mode, angles beyond a single turn
+// are fine. Returns an error in wheel mode. The limits (and mode) are read from
+// the servo each time, so enable the register cache (see SetCached) to avoid
+// that in a control loop.
+func (s *Servo) MoveTo(a Angle) error {
+	if s.model == nil {
+		return errors.New("can't convert angle of servo of unknown model")
+	}
 
-// Voltage returns the current voltage supplied. Unlike the underlying register,
-// this is the actual voltage, not multiplied by ten.
-func (s *Servo) Voltage() (float64, error) {
-	val, err := s.PresentVoltage()
+	lo, hi, err := s.positionLimits()
 	if err != nil {
-		return 0.0, err
+		return err
+	}
+
+	p := s.model.AngleToPosition((a + s.origin).Degrees())
+	if p < lo {
+		p = lo
+	} else if p > hi {
+		p = hi
 	}
 
-	// Convert the return value into actual volts.
-	return (float64(val) / 10), nil
+	return s.SetGoalPosition(p)
 }
 
-//
-func normalizeAngle(d float64) float64 {
-	if d > 180 {
-		return normalizeAngle(d - 360)
+// positionLimits returns the lowest and highest goal positions which the servo
+// will move to, in its current mode.
+func (s *Servo) positionLimits() (int, int, error) {
+	r, ok := s.registers[reg.GoalPosition]
+	if !ok {
+		return 0, 0, fmt.Errorf("can't write to unsupported register: %v", reg.GoalPosition)
+	}
+
+	if _, ok := s.registers[reg.CcwAngleLimit]; !ok {
+		return r.Min, r.Max, nil
+	}
 
-	} else if d < -180 {
-		return normalizeAngle(d + 360)
+	// Servos without a mode register are in whichever mode their limits say,
+	// so only read them once.
+	var m Mode
+	var lo, hi int
+	var err error
 
+	_, cm := s.registers[reg.ControlMode]
+	_, om := s.registers[reg.OperatingMode]
+	if cm || om {
+		m, err = s.Mode()
+		if err == nil && m == Joint {
+			lo, hi, err = s.readAngleLimits()
+		}
 	} else {
-		return d
+		lo, hi, err = s.readAngleLimits()
+		m = s.limitsMode(lo, hi)
+	}
+
+	if err != nil {
+		return 0, 0, err
 	}
+
+	switch m {
+	case Wheel:
+		return 0, 0, errors.New("can't move to an angle in wheel mode")
+
+	case MultiTurn:
+		return r.Min, r.Max, nil
+	}
+
+	if lo < r.Min {
+		lo = r.Min
+	}
+
+	if hi > r.Max {
+		hi = r.Max
+	}
+
+	return lo, hi, nil
+}
+
+// Voltage returns the current voltage supplied. Unlike the underlying register,
+// this is the actual voltage, not multiplied by ten.
+func (s *Servo) Voltage() (float64, error) {
+	v, _, err := s.ReadPhysical(reg.PresentVoltage)
+	return v, err
 }
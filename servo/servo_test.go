@@ -14,6 +14,7 @@ var (
 	rwTwoByte     = reg.RegName(4)
 	invalidLength = reg.RegName(5)
 	unsupported   = reg.RegName(6)
+	rwFourByte    = reg.RegName(7)
 )
 
 func TestGetRegister(t *testing.T) {
@@ -85,6 +86,28 @@ func TestSetRegister(t *testing.T) {
 	assert.Equal(t, byte(0x00), p.controlTable[5], "control table should NOT have been written")
 }
 
+func TestSignedRegister(t *testing.T) {
+	m := reg.Map{
+		rwFourByte: &reg.Register{Address: 0x04, Length: 4, Access: reg.RW, Min: -250961, Max: 250961, Signed: true},
+	}
+
+	p, servo := servo(m, map[int]byte{})
+
+	err := servo.setRegister(rwFourByte, -250961)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xaf, 0x2b, 0xfc, 0xff}, p.controlTable[4:8])
+
+	r, err := servo.getRegister(rwFourByte)
+	assert.NoError(t, err)
+	assert.Equal(t, -250961, r)
+
+	err = servo.setRegister(rwFourByte, -250962)
+	assert.EqualError(t, err, "value too low: -250962 (min=-250961)")
+
+	err = servo.setRegister(rwFourByte, 250962)
+	assert.EqualError(t, err, "value too high: 250962 (max=250961)")
+}
+
 func TestSetSetBuffered(t *testing.T) {
 	m := reg.Map{
 		rwOneByte: &reg.Register{Address: 0x01, Length: 1, Access: reg.RW, Min: 0, Max: 1},
@@ -111,7 +134,7 @@ func TestVoltage(t *testing.T) {
 	// Fake servo which only supports PresentVoltage
 
 	m := reg.Map{
-		reg.PresentVoltage: {0x00, 1, reg.RO, 0, 0},
+		reg.PresentVoltage: {0x00, 1, reg.RO, 0, 0, false, reg.Volts, 0.1, reg.RAM},
 	}
 
 	examples := map[byte]float64{
@@ -130,6 +153,84 @@ func TestVoltage(t *testing.T) {
 	}
 }
 
+func TestTypedAccessors(t *testing.T) {
+	m := reg.Map{
+		reg.Moving:        {0x00, 1, reg.RO, 0, 0, false, reg.None, 0, reg.RAM},
+		reg.OperatingMode: {0x01, 1, reg.RW, 0, 16, false, reg.None, 0, reg.RAM},
+	}
+
+	p, s := servo(m, map[int]byte{0x00: 1})
+
+	moving, err := s.Moving()
+	assert.NoError(t, err)
+	assert.True(t, moving)
+
+	err = s.SetOperatingMode(PWMControl)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(16), p.controlTable[0x01])
+
+	mode, err := s.OperatingMode()
+	assert.NoError(t, err)
+	assert.Equal(t, PWMControl, mode)
+}
+
+func TestPhysical(t *testing.T) {
+	m := reg.Map{
+		reg.PresentVoltage: {0x00, 1, reg.RO, 0, 0, false, reg.Volts, 0.1, reg.RAM},
+		reg.GoalPosition:   {0x01, 2, reg.RW, 0, 1023, false, reg.Degrees, 300.0 / 1023, reg.RAM},
+		reg.BaudRate:       {0x03, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},
+	}
+
+	p, s := servo(m, map[int]byte{0x00: 95, 0x03: 1})
+
+	v, u, err := s.ReadPhysical(reg.PresentVoltage)
+	assert.NoError(t, err)
+	assert.Equal(t, reg.Volts, u)
+	assert.InDelta(t, 9.5, v, 0.0001)
+
+	err = s.WritePhysical(reg.GoalPosition, 60)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xcd, 0x00}, p.controlTable[1:3]) // 205
+
+	v, u, err = s.ReadPhysical(reg.BaudRate)
+	assert.NoError(t, err)
+	assert.Equal(t, reg.None, u)
+	assert.Equal(t, 1.0, v)
+
+	err = s.WritePhysical(reg.GoalPosition, 301)
+	assert.EqualError(t, err, "value too high: 1026 (max=1023)")
+}
+
+func TestEEPROMWrites(t *testing.T) {
+	m := reg.Map{
+		rwOneByte: {0x01, 1, reg.RW, 0, 10, false, reg.None, 0, reg.EEPROM},
+		rwTwoByte: {0x02, 2, reg.RW, 0, 10, false, reg.None, 0, reg.RAM},
+	}
+
+	p, s := servo(m, map[int]byte{0x01: 5})
+
+	// unchanged, so not written
+	err := s.setRegister(rwOneByte, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, s.EEPROMWrites())
+
+	err = s.setRegister(rwOneByte, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(6), p.controlTable[0x01])
+	assert.Equal(t, 1, s.EEPROMWrites())
+
+	// RAM writes aren't counted
+	err = s.setRegister(rwTwoByte, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.EEPROMWrites())
+
+	// changing the ID changes which servo we talk to
+	err = s.SetServoID(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, s.ID)
+	assert.Equal(t, 2, s.EEPROMWrites())
+}
+
 // -----------------------------------------------------------------------------
 
 type writeEvent struct {
@@ -142,6 +243,10 @@ type writeEvent struct {
 type mockProto struct {
 	controlTable [50]byte
 	writeBuf     []writeEvent
+	reads        int
+
+	// Every WriteData, in order.
+	writes []writeEvent
 }
 
 // servo returns a real Servo backed by a mock network, where the control table
@@ -153,8 +258,8 @@ func servo(r reg.Map, b map[int]byte) (*mockProto, *Servo) {
 	// Start with the minimal set of registers, which are required for anything
 	// to work. Everything else is optional, so we leave it to the test(s).
 	m := reg.Map{
-		reg.ServoID:           {40, 1, reg.RW, 0, 252},
-		reg.StatusReturnLevel: {41, 1, reg.RW, 0, 2},
+		reg.ServoID:           {40, 1, reg.RW, 0, 252, false, reg.None, 0, reg.EEPROM},
+		reg.StatusReturnLevel: {41, 1, reg.RW, 0, 2, false, reg.None, 0, reg.EEPROM},
 	}
 
 	// Add the given registers
@@ -182,10 +287,12 @@ func (p *mockProto) Ping(ident int) error {
 }
 
 func (p *mockProto) ReadData(ident int, addr int, count int) ([]byte, error) {
+	p.reads++
 	return p.controlTable[int(addr) : int(addr)+count], nil
 }
 
 func (p *mockProto) WriteData(ident int, address int, data []byte, expectResponse bool) error {
+	p.writes = append(p.writes, writeEvent{address, data})
 	for i, val := range data {
 		p.controlTable[address+i] = val
 	}
@@ -0,0 +1,132 @@
+package servo
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// maxSpan is the most bytes which Snapshot will read in one instruction. The
+// servos have small receive buffers (the AX-12's is 143 bytes, including the
+// packet header), so this keeps the status packets well within them.
+const maxSpan = 128
+
+// Snapshot is the values of several registers, as read at (roughly) the same
+// time by Servo.Snapshot.
+type Snapshot struct {
+
+	// The time at which the first read was sent.
+	Time time.Time
+
+	// The value of each register which was read.
+	Values map[reg.RegName]int
+
+	registers reg.Map
+}
+
+// Value returns the value of the given register, and whether it was read.
+func (ss *Snapshot) Value(n reg.RegName) (int, bool) {
+	v, ok := ss.Values[n]
+	return v, ok
+}
+
+// Physical returns the value of the given register in its physical unit, along
+// with that unit, and whether it was read. See Servo.ReadPhysical.
+func (ss *Snapshot) Physical(n reg.RegName) (float64, reg.Unit, bool) {
+	v, ok := ss.Values[n]
+	if !ok {
+		return 0, reg.None, false
+	}
+
+	r := ss.registers[n]
+	return r.ToPhysical(v), r.Unit, true
+}
+
+// span is a contiguous range of the control table, which is read in one go.
+type span struct {
+	address int
+	length  int
+	names   []reg.RegName
+}
+
+// spans returns the fewest spans (of at most maxSpan bytes) which cover all of
+// the given registers, ordered by address. Gaps between registers are read
+// too, because that's much cheaper than another round trip.
+func spans(m reg.Map, names []reg.RegName) []span {
+	sorted := append([]reg.RegName(nil), names...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return m[sorted[i]].Address < m[sorted[j]].Address
+	})
+
+	var out []span
+	for _, n := range sorted {
+		r := m[n]
+
+		if len(out) > 0 {
+			sp := &out[len(out)-1]
+			end := r.Address + r.Length
+			if end-sp.address <= maxSpan {
+				if end > sp.address+sp.length {
+					sp.length = end - sp.address
+				}
+
+				sp.names = append(sp.names, n)
+				continue
+			}
+		}
+
+		out = append(out, span{r.Address, r.Length, []reg.RegName{n}})
+	}
+
+	return out
+}
+
+// Snapshot reads the given registers with as few instructions as possible, by
+// reading the contiguous ranges of the control table which cover them rather
+// than one register at a time. For example, the AX-12 registers from
+// PresentPosition to Moving can be read in one go.
+func (s *Servo) Snapshot(names ...reg.RegName) (*Snapshot, error) {
+	for _, n := range names {
+		r, ok := s.registers[n]
+		if !ok {
+			return nil, fmt.Errorf("can't read unsupported register: %v", n)
+		}
+
+		if r.Length != 1 && r.Length != 2 && r.Length != 4 {
+			return nil, fmt.Errorf("invalid register length: %d", r.Length)
+		}
+	}
+
+	ss := &Snapshot{
+		Time:      time.Now(),
+		Values:    make(map[reg.RegName]int, len(names)),
+		registers: s.registers,
+	}
+
+	for _, sp := range spans(s.registers, names) {
+		b, err := s.readData(sp.address, sp.length)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) != sp.length {
+			return nil, fmt.Errorf("expected %d bytes, got %d", sp.length, len(b))
+		}
+
+		for _, n := range sp.names {
+			r := s.registers[n]
+			off := r.Address - sp.address
+
+			v, err := r.Decode(b[off : off+r.Length])
+			if err != nil {
+				return nil, err
+			}
+
+			ss.Values[n] = v
+		}
+	}
+
+	return ss, nil
+}
@@ -0,0 +1,68 @@
+package servo
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpans(t *testing.T) {
+	m := reg.Map{
+		reg.ModelNumber:         {Address: 0, Length: 2},
+		reg.TorqueEnable:        {Address: 562, Length: 1},
+		reg.PresentPosition:     {Address: 611, Length: 4},
+		reg.PresentSpeed:        {Address: 615, Length: 4},
+		reg.HardwareErrorStatus: {Address: 892, Length: 1},
+	}
+
+	sp := spans(m, []reg.RegName{reg.PresentSpeed, reg.HardwareErrorStatus, reg.ModelNumber, reg.PresentPosition, reg.TorqueEnable})
+	assert.Equal(t, []span{
+		{0, 2, []reg.RegName{reg.ModelNumber}},
+		{562, 57, []reg.RegName{reg.TorqueEnable, reg.PresentPosition, reg.PresentSpeed}},
+		{892, 1, []reg.RegName{reg.HardwareErrorStatus}},
+	}, sp)
+}
+
+func TestSnapshot(t *testing.T) {
+	m := reg.Map{
+		reg.PresentPosition:    {Address: 0x00, Length: 2, Unit: reg.Degrees, Scale: 0.5},
+		reg.PresentSpeed:       {Address: 0x02, Length: 2},
+		reg.PresentTemperature: {Address: 0x06, Length: 1},
+		reg.Moving:             {Address: 0x08, Length: 1},
+	}
+
+	p, s := servo(m, map[int]byte{
+		0x00: 0x00,
+		0x01: 0x02,
+		0x02: 0x10,
+		0x06: 0x28,
+		0x08: 0x01,
+	})
+
+	ss, err := s.Snapshot(reg.Moving, reg.PresentPosition, reg.PresentTemperature, reg.PresentSpeed)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, p.reads)
+	assert.False(t, ss.Time.IsZero())
+	assert.Equal(t, map[reg.RegName]int{
+		reg.PresentPosition:    512,
+		reg.PresentSpeed:       16,
+		reg.PresentTemperature: 40,
+		reg.Moving:             1,
+	}, ss.Values)
+
+	v, ok := ss.Value(reg.PresentTemperature)
+	assert.True(t, ok)
+	assert.Equal(t, 40, v)
+
+	f, u, ok := ss.Physical(reg.PresentPosition)
+	assert.True(t, ok)
+	assert.Equal(t, 256.0, f)
+	assert.Equal(t, reg.Degrees, u)
+
+	_, ok = ss.Value(reg.GoalPosition)
+	assert.False(t, ok)
+
+	_, err = s.Snapshot(reg.GoalPosition)
+	assert.Error(t, err)
+}
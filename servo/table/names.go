@@ -0,0 +1,79 @@
+package table
+
+import (
+	"strings"
+
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// aliases maps the ROBOTIS names of registers (with spaces and parentheses
+// removed) to the RegNames used by this package, where they differ.
+var aliases = map[string]reg.RegName{
+	"ID":                  reg.ServoID,
+	"LED":                 reg.Led,
+	"PresentVelocity":     reg.PresentSpeed,
+	"MaxPositionLimit":    reg.CcwAngleLimit,
+	"MinPositionLimit":    reg.CwAngleLimit,
+	"TemperatureLimit":    reg.HighestLimitTemperature,
+	"MaxVoltageLimit":     reg.HighestLimitVoltage,
+	"MinVoltageLimit":     reg.LowestLimitVoltage,
+	"PresentInputVoltage": reg.PresentVoltage,
+	"Shutdown":            reg.AlarmShutdown,
+	"PositionPGain":       reg.PGain,
+	"PositionIGain":       reg.IGain,
+	"PositionDGain":       reg.DGain,
+	"SecondaryShadowID":   reg.SecondaryID,
+	"Registered":          reg.RegisteredInstruction,
+}
+
+// signed lists the registers which are usually two's complement, for .model
+// files, which don't say.
+var signed = map[reg.RegName]bool{
+	reg.HomingOffset:       true,
+	reg.GoalPWM:            true,
+	reg.GoalCurrent:        true,
+	reg.GoalVelocity:       true,
+	reg.GoalPosition:       true,
+	reg.PresentPWM:         true,
+	reg.PresentCurrent:     true,
+	reg.PresentLoad:        true,
+	reg.PresentSpeed:       true,
+	reg.PresentPosition:    true,
+	reg.VelocityTrajectory: true,
+	reg.PositionTrajectory: true,
+}
+
+// lookup returns the RegName with the given name, which can be a RegName (e.g.
+// "GoalPosition") or a ROBOTIS name (e.g. "Goal Position").
+func lookup(name string) (reg.RegName, bool) {
+	s := normalize(name)
+
+	if n, ok := aliases[s]; ok {
+		return n, true
+	}
+
+	return reg.ByName(s)
+}
+
+// readOnly returns true if the given register name looks like one which can't
+// be written, for .model files which don't say.
+func readOnly(name string) bool {
+	s := normalize(name)
+
+	if strings.HasPrefix(s, "Present") {
+		return true
+	}
+
+	switch s {
+	case "ModelNumber", "ModelInformation", "FirmwareVersion", "Registered",
+		"RegisteredInstruction", "HardwareErrorStatus", "RealtimeTick",
+		"Moving", "MovingStatus", "VelocityTrajectory", "PositionTrajectory":
+		return true
+	}
+
+	return false
+}
+
+func normalize(name string) string {
+	return strings.NewReplacer(" ", "", "(", "", ")", "", "_", "").Replace(name)
+}
@@ -0,0 +1,439 @@
+// Package table loads control table definitions from data files, so that new
+// models can be supported without writing (or waiting for) Go code like the
+// register maps in servo/ax.
+//
+// Three formats are supported: YAML and JSON, which share the same structure
+// (see Table), and the tab-separated .model files which ROBOTIS ships with its
+// ROS packages.
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"go.yaml.in/yaml/v3"
+)
+
+// Table is a control table definition, as loaded from a file.
+type Table struct {
+	Name     string `json:"name" yaml:"name"`
+	Number   int    `json:"number" yaml:"number"`
+	Protocol int    `json:"protocol" yaml:"protocol"`
+
+	// Unit conversions for positions. See servo.Model.
+	Steps  int     `json:"steps" yaml:"steps"`
+	Range  float64 `json:"range" yaml:"range"`
+	Center int     `json:"center" yaml:"center"`
+
+	Registers []Register `json:"registers" yaml:"registers"`
+
+	// The name of the alarm flag (e.g. "overheating") which each bit of the
+	// AlarmLed, AlarmShutdown and HardwareErrorStatus registers represents, if
+	// they differ from the protocol 1 error byte. Unused bits are "". See
+	// servo.Model.
+	Alarms []string `json:"alarms,omitempty" yaml:"alarms,omitempty"`
+
+	// The indirect address region, if there is one. See servo.Model.
+	Indirect *Indirect `json:"indirect,omitempty" yaml:"indirect,omitempty"`
+
+	// The names of registers in a .model file which don't correspond to any
+	// known RegName, and so were skipped.
+	Skipped []string `json:"-" yaml:"-"`
+}
+
+// Register is a single register in a Table.
+type Register struct {
+
+	// The name of the register, which must be a RegName (e.g. "GoalPosition").
+	// The names used by ROBOTIS (e.g. "Goal Position") are also accepted.
+	Name string `json:"name" yaml:"name"`
+
+	Address int    `json:"address" yaml:"address"`
+	Size    int    `json:"size" yaml:"size"`
+	Access  string `json:"access" yaml:"access"` // R or RW
+	Min     int    `json:"min" yaml:"min"`
+	Max     int    `json:"max" yaml:"max"`
+	Signed  bool   `json:"signed" yaml:"signed"`
+
+	// The physical unit of the value (e.g. "degrees" or "deg"), and the size
+	// of each step in that unit. See reg.ParseUnit.
+	Unit  string  `json:"unit,omitempty" yaml:"unit,omitempty"`
+	Scale float64 `json:"scale,omitempty" yaml:"scale,omitempty"`
+
+	// EEPROM or RAM. The default is RAM.
+	Area string `json:"area,omitempty" yaml:"area,omitempty"`
+}
+
+// Indirect is the indirect address region of a Table. See reg.Indirect.
+type Indirect struct {
+	Address int `json:"address" yaml:"address"`
+	Data    int `json:"data" yaml:"data"`
+	Count   int `json:"count" yaml:"count"`
+}
+
+// Load reads a table from the given file. The format is chosen by extension:
+// .yaml or .yml, .json, or .model. The name of .model files, which don't
+// contain one, is the file name without the extension.
+func Load(path string) (*Table, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := filepath.Ext(path)
+	switch strings.ToLower(ext) {
+	case ".yaml", ".yml":
+		return ParseYAML(b)
+
+	case ".json":
+		return ParseJSON(b)
+
+	case ".model":
+		t, err := ParseModel(b)
+		if err != nil {
+			return nil, err
+		}
+
+		t.Name = strings.TrimSuffix(filepath.Base(path), ext)
+		return t, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported file type: %s", ext)
+	}
+}
+
+// ParseYAML parses a table from YAML. Unknown fields are an error.
+func ParseYAML(b []byte) (*Table, error) {
+	t := &Table{}
+
+	d := yaml.NewDecoder(bytes.NewReader(b))
+	d.KnownFields(true)
+	err := d.Decode(t)
+	if err != nil {
+		return nil, fmt.Errorf("parsing yaml: %s", err)
+	}
+
+	return t, nil
+}
+
+// ParseJSON parses a table from JSON. Unknown fields are an error.
+func ParseJSON(b []byte) (*Table, error) {
+	t := &Table{}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.DisallowUnknownFields()
+	err := d.Decode(t)
+	if err != nil {
+		return nil, fmt.Errorf("parsing json: %s", err)
+	}
+
+	return t, nil
+}
+
+// ParseModel parses a table from a ROBOTIS .model file. These are for protocol
+// 2 servos, and contain a [type info] section with position conversions, and
+// a [control table] section with one tab-separated register per line:
+//
+//	Address	Size	Data Name
+//	116	4	Goal Position
+//
+// Access, min and max columns are used if present. Otherwise, registers named
+// like read-only values (e.g. "Present Position") are R, and the rest are RW
+// with the full range of their size. Registers before Torque Enable are EEPROM.
+// Registers with unknown names are skipped, and listed in Skipped. The alarm
+// bits and indirect address region aren't included, so must be set afterwards
+// if the model differs from the defaults.
+func ParseModel(b []byte) (*Table, error) {
+	t := &Table{Protocol: 2}
+	info := map[string]float64{}
+	section := ""
+
+	for i, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			section = strings.Trim(strings.TrimSpace(line), "[]")
+			continue
+		}
+
+		cols := strings.Split(line, "\t")
+
+		switch section {
+		case "type info":
+			if len(cols) < 2 || cols[0] == "name" {
+				continue
+			}
+
+			var v float64
+			_, err := fmt.Sscan(cols[1], &v)
+			if err == nil {
+				info[cols[0]] = v
+			}
+
+		case "control table":
+			if len(cols) < 3 || cols[0] == "Address" {
+				continue
+			}
+
+			r, err := parseModelRow(cols)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", i+1, err)
+			}
+
+			if _, ok := lookup(r.Name); !ok {
+				t.Skipped = append(t.Skipped, r.Name)
+				continue
+			}
+
+			t.Registers = append(t.Registers, r)
+		}
+	}
+
+	if len(t.Registers) == 0 {
+		return nil, fmt.Errorf("no control table")
+	}
+
+	// The area isn't included, but EEPROM always comes before Torque Enable.
+	for _, r := range t.Registers {
+		if n, _ := lookup(r.Name); n == reg.TorqueEnable {
+			for i := range t.Registers {
+				if t.Registers[i].Address < r.Address {
+					t.Registers[i].Area = "EEPROM"
+				}
+			}
+		}
+	}
+
+	zero := info["value_of_zero_radian_position"]
+	min, max := info["value_of_min_radian_position"], info["value_of_max_radian_position"]
+	if max > min {
+		t.Center = int(zero)
+		t.Steps = int(max - min)
+		t.Range = (info["max_radian"] - info["min_radian"]) * 180 / math.Pi
+
+		// A full turn ends one step before it starts again, so has one more
+		// step than the positions span (e.g. 4096 for 0-4095), like the
+		// built-in tables.
+		if math.Abs(t.Range-360) < 0.01 {
+			t.Steps++
+			t.Range = 360
+		}
+	}
+
+	return t, nil
+}
+
+func parseModelRow(cols []string) (Register, error) {
+	r := Register{Name: strings.TrimSpace(cols[2])}
+
+	_, err := fmt.Sscan(cols[0], &r.Address)
+	if err != nil {
+		return r, fmt.Errorf("bad address: %q", cols[0])
+	}
+
+	_, err = fmt.Sscan(cols[1], &r.Size)
+	if err != nil {
+		return r, fmt.Errorf("bad size: %q", cols[1])
+	}
+
+	n, _ := lookup(r.Name)
+	r.Signed = signed[n]
+
+	r.Access = "RW"
+	if readOnly(r.Name) {
+		r.Access = "R"
+	}
+	if len(cols) > 3 && strings.TrimSpace(cols[3]) != "" {
+		r.Access = strings.TrimSpace(cols[3])
+	}
+
+	bits := uint(r.Size * 8)
+	r.Min, r.Max = 0, 1<<bits-1
+	if r.Signed {
+		r.Min, r.Max = -1<<(bits-1), 1<<(bits-1)-1
+	}
+
+	// Columns 5 and 6 are min and max; column 4 is the initial value.
+	if len(cols) > 6 {
+		_, err = fmt.Sscan(cols[5], &r.Min)
+		if err != nil {
+			return r, fmt.Errorf("bad min: %q", cols[5])
+		}
+
+		_, err = fmt.Sscan(cols[6], &r.Max)
+		if err != nil {
+			return r, fmt.Errorf("bad max: %q", cols[6])
+		}
+	}
+
+	return r, nil
+}
+
+// Map returns the register map of the table, or an error if any register is
+// invalid.
+func (t *Table) Map() (reg.Map, error) {
+	m := reg.Map{}
+
+	for _, r := range t.Registers {
+		n, ok := lookup(r.Name)
+		if !ok {
+			return nil, fmt.Errorf("unknown register: %s", r.Name)
+		}
+
+		if _, ok := m[n]; ok {
+			return nil, fmt.Errorf("duplicate register: %s", r.Name)
+		}
+
+		if r.Size != 1 && r.Size != 2 && r.Size != 4 {
+			return nil, fmt.Errorf("invalid size of %s: %d", r.Name, r.Size)
+		}
+
+		if r.Min > r.Max {
+			return nil, fmt.Errorf("invalid range of %s: %d-%d", r.Name, r.Min, r.Max)
+		}
+
+		var a reg.Access
+		switch strings.ToUpper(r.Access) {
+		case "R", "RO":
+			a = reg.RO
+
+		case "RW", "W":
+			a = reg.RW
+
+		default:
+			return nil, fmt.Errorf("invalid access of %s: %q", r.Name, r.Access)
+		}
+
+		u, err := reg.ParseUnit(r.Unit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid unit of %s: %q", r.Name, r.Unit)
+		}
+
+		var area reg.Area
+		switch strings.ToUpper(r.Area) {
+		case "", "RAM":
+			area = reg.RAM
+
+		case "EEPROM":
+			area = reg.EEPROM
+
+		default:
+			return nil, fmt.Errorf("invalid area of %s: %q", r.Name, r.Area)
+		}
+
+		m[n] = &reg.Register{
+			Address: r.Address,
+			Length:  r.Size,
+			Access:  a,
+			Min:     r.Min,
+			Max:     r.Max,
+			Signed:  r.Signed,
+			Unit:    u,
+			Scale:   r.Scale,
+			Area:    area,
+		}
+	}
+
+	return m, nil
+}
+
+// Model returns the table as a servo.Model.
+func (t *Table) Model() (*servo.Model, error) {
+	m, err := t.Map()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Protocol != 1 && t.Protocol != 2 {
+		return nil, fmt.Errorf("invalid protocol: %d", t.Protocol)
+	}
+
+	alarms, err := t.alarms()
+	if err != nil {
+		return nil, err
+	}
+
+	mod := &servo.Model{
+		Name:      t.Name,
+		Number:    t.Number,
+		Protocol:  t.Protocol,
+		Registers: m,
+		Steps:     t.Steps,
+		Range:     t.Range,
+		Center:    t.Center,
+		Alarms:    alarms,
+	}
+
+	if i := t.Indirect; i != nil {
+		if i.Count <= 0 {
+			return nil, fmt.Errorf("invalid indirect count: %d", i.Count)
+		}
+
+		mod.Indirect = reg.Indirect{Address: i.Address, Data: i.Data, Count: i.Count}
+	}
+
+	return mod, nil
+}
+
+// alarms returns the alarm bits of the table, or an error if any of the names
+// isn't an alarm flag.
+func (t *Table) alarms() (reg.AlarmBits, error) {
+	var b reg.AlarmBits
+	if len(t.Alarms) > len(b) {
+		return b, fmt.Errorf("too many alarm bits: %d", len(t.Alarms))
+	}
+
+	for i, name := range t.Alarms {
+		if name == "" {
+			continue
+		}
+
+		f, ok := alarmFlag(name)
+		if !ok {
+			return b, fmt.Errorf("unknown alarm: %s", name)
+		}
+
+		b[i] = f
+	}
+
+	return b, nil
+}
+
+// alarmFlag returns the alarm flag with the given name, e.g. "overheating".
+func alarmFlag(name string) (reg.AlarmFlags, bool) {
+	for i := uint(0); i < 16; i++ {
+		f := reg.AlarmFlags(1 << i)
+		if n := f.Names(); len(n) == 1 && n[0] == strings.ToLower(name) {
+			return f, true
+		}
+	}
+
+	return 0, false
+}
+
+// Register adds the table to the models which can be detected by servo.Detect.
+// Returns an error if the table is invalid, or if a model with the same number
+// has already been registered.
+func (t *Table) Register() error {
+	m, err := t.Model()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := servo.LookupModel(m.Protocol, m.Number); ok {
+		return fmt.Errorf("model already registered: %d (protocol %d)", m.Number, m.Protocol)
+	}
+
+	servo.RegisterModel(m)
+	return nil
+}
@@ -0,0 +1,138 @@
+package table
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/x"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadYAML(t *testing.T) {
+	tbl, err := Load("testdata/example.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "Example", tbl.Name)
+	assert.Equal(t, 1, tbl.Protocol)
+	assert.Equal(t, "degrees", tbl.Registers[3].Unit)
+	assert.Equal(t, 0.29, tbl.Registers[3].Scale)
+
+	m, err := tbl.Map()
+	if assert.NoError(t, err) {
+		assert.Equal(t, &reg.Register{30, 2, reg.RW, 0, 1023, false, reg.Degrees, 0.29, reg.RAM}, m[reg.GoalPosition])
+		assert.Equal(t, reg.RO, m[reg.PresentPosition].Access)
+		assert.Len(t, m, 5)
+	}
+
+	err = tbl.Register()
+	if assert.NoError(t, err) {
+		mod, ok := servo.LookupModel(1, 9999)
+		if assert.True(t, ok) {
+			assert.Equal(t, "Example", mod.Name)
+			assert.Equal(t, 512, mod.AngleToPosition(0))
+			assert.Equal(t, 1024, mod.AngleToPosition(150))
+		}
+	}
+
+	err = tbl.Register()
+	assert.EqualError(t, err, "model already registered: 9999 (protocol 1)")
+}
+
+func TestParseJSON(t *testing.T) {
+	tbl, err := ParseJSON([]byte(`{"name": "J", "protocol": 2, "registers": [
+		{"name": "GoalPosition", "address": 116, "size": 4, "access": "RW", "min": -100, "max": 100, "signed": true}
+	], "alarms": ["input voltage", "", "overheating", "motor encoder"], "indirect": {"address": 168, "data": 224, "count": 28}}`))
+	if assert.NoError(t, err) {
+		m, err := tbl.Map()
+		if assert.NoError(t, err) {
+			assert.Equal(t, &reg.Register{116, 4, reg.RW, -100, 100, true, reg.None, 0, reg.RAM}, m[reg.GoalPosition])
+		}
+
+		mod, err := tbl.Model()
+		if assert.NoError(t, err) {
+			assert.Equal(t, reg.AlarmBits{reg.InputVoltage, 0, reg.Overheating, reg.MotorEncoder}, mod.Alarms)
+			assert.Equal(t, x.Indirect, mod.Indirect)
+		}
+	}
+
+	tbl.Alarms = []string{"gremlins"}
+	_, err = tbl.Model()
+	assert.EqualError(t, err, "unknown alarm: gremlins")
+
+	_, err = ParseJSON([]byte(`{"name": "J", "nope": 1}`))
+	assert.Error(t, err)
+}
+
+func TestParseModel(t *testing.T) {
+	tbl, err := Load("testdata/XM430-W350.model")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "XM430-W350", tbl.Name)
+	assert.Equal(t, 2, tbl.Protocol)
+	assert.Equal(t, 4096, tbl.Steps)
+	assert.Equal(t, 2048, tbl.Center)
+	assert.Equal(t, 360.0, tbl.Range)
+	assert.Equal(t, []string{"Indirect Address 1"}, tbl.Skipped)
+
+	m, err := tbl.Map()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// The registers which were loaded should match the built-in table, apart
+	// from the limits, which the .model file doesn't contain.
+	for n, r := range m {
+		exp := x.XM430W350[n]
+		if assert.NotNil(t, exp, n.String()) {
+			assert.Equal(t, exp.Address, r.Address, n.String())
+			assert.Equal(t, exp.Length, r.Length, n.String())
+			assert.Equal(t, exp.Access, r.Access, n.String())
+			assert.Equal(t, exp.Signed, r.Signed, n.String())
+			assert.Equal(t, exp.Area, r.Area, n.String())
+		}
+	}
+
+	assert.Equal(t, -2147483648, m[reg.GoalPosition].Min)
+	assert.Equal(t, 2147483647, m[reg.GoalPosition].Max)
+	assert.Equal(t, 0, m[reg.Led].Min)
+	assert.Equal(t, 255, m[reg.Led].Max)
+
+	// Angles convert the same way as the built-in table.
+	mod, err := tbl.Model()
+	if assert.NoError(t, err) {
+		exp, _ := servo.LookupModel(2, 1020)
+		assert.Equal(t, exp.AngleToPosition(90), mod.AngleToPosition(90))
+	}
+}
+
+func TestParseModelErrors(t *testing.T) {
+	_, err := ParseModel([]byte("[control table]\nAddress\tSize\tData Name\tAccess\tInitial\tMin\tMax\n65\t1\tLED\tRW\t0\t0\tone\n"))
+	assert.EqualError(t, err, "line 3: bad max: \"one\"")
+
+	_, err = ParseModel([]byte("[control table]\nx\t1\tLED\n"))
+	assert.EqualError(t, err, "line 2: bad address: \"x\"")
+}
+
+func TestMapErrors(t *testing.T) {
+	for exp, r := range map[string]Register{
+		"unknown register: Nope":          {Name: "Nope", Size: 1, Access: "RW"},
+		"invalid size of Led: 3":          {Name: "Led", Size: 3, Access: "RW"},
+		"invalid range of Led: 2-1":       {Name: "Led", Size: 1, Access: "RW", Min: 2, Max: 1},
+		"invalid access of Led: \"X\"":    {Name: "Led", Size: 1, Access: "X"},
+		"invalid area of Led: \"FLASH\"":  {Name: "Led", Size: 1, Access: "RW", Area: "FLASH"},
+		"invalid unit of Led: \"lumens\"": {Name: "Led", Size: 1, Access: "RW", Unit: "lumens"},
+	} {
+		tbl := &Table{Registers: []Register{r}}
+		_, err := tbl.Map()
+		assert.EqualError(t, err, exp)
+	}
+
+	tbl := &Table{Registers: []Register{{Name: "LED", Size: 1, Access: "RW"}, {Name: "Led", Size: 1, Access: "RW"}}}
+	_, err := tbl.Map()
+	assert.EqualError(t, err, "duplicate register: Led")
+}
@@ -0,0 +1,173 @@
+package servo
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Tracker follows the angle of a servo which turns continuously (e.g. in wheel
+// mode), across any number of turns. PresentPosition only covers a single turn
+// (or less: the AX-12 doesn't report positions in the 60 degrees between its
+// angle limits), so Tracker samples it along with PresentSpeed, and counts the
+// times it wraps around.
+//
+// Servos in multi-turn mode (see SetMode) count their turns themselves, so in
+// that case Tracker just reads PresentPosition.
+//
+// Update must be called often enough that the servo turns less than half a
+// turn between calls. More often is better, because the angle in the dead band
+// is estimated from the speed.
+//
+//	t, err := servo.NewTracker(s)
+//	for {
+//	  err = t.Update()
+//	  fmt.Printf("%.2f revolutions\n", t.Revolutions())
+//	}
+type Tracker struct {
+	s      *Servo
+	model  *Model
+	native bool
+
+	// Whether the servo reports its speed in a known unit. If not, it's
+	// estimated from the positions.
+	speed bool
+
+	// The cumulative angle (in degrees) and velocity (in degrees per second)
+	// when Update was last called, and when that was.
+	angle float64
+	vel   float64
+	time  time.Time
+
+	// The angle which Angle returns as zero. See Reset.
+	offset float64
+
+	started bool
+
+	// The source of time, which defaults to time.Now. Replace it (e.g. with the
+	// Now method of a simulator.VirtualClock) if the servo isn't in real time.
+	Now func() time.Time
+}
+
+// NewTracker returns a tracker for the given servo. The model of the servo must
+// be known. If the servo is in multi-turn mode, its own turn count is used, so
+// don't change its mode while tracking it.
+func NewTracker(s *Servo) (*Tracker, error) {
+	if s.model == nil || s.model.Steps == 0 || s.model.Range == 0 {
+		return nil, errors.New("can't track servo of unknown model")
+	}
+
+	m, err := s.Mode()
+	if err != nil {
+		return nil, err
+	}
+
+	speed := false
+	if _, ok := s.registers[reg.PresentSpeed]; ok {
+		_, err := s.velocity(0)
+		speed = err == nil
+	}
+
+	return &Tracker{
+		s:      s,
+		model:  s.model,
+		native: m == MultiTurn,
+		speed:  speed,
+		Now:    time.Now,
+	}, nil
+}
+
+// Native returns true if the servo counts its own turns, i.e. it's in
+// multi-turn mode.
+func (t *Tracker) Native() bool {
+	return t.native
+}
+
+// Update reads the position and speed of the servo, and updates the angle.
+func (t *Tracker) Update() error {
+	names := []reg.RegName{reg.PresentPosition}
+	if t.speed {
+		names = append(names, reg.PresentSpeed)
+	}
+
+	ss, err := t.s.Snapshot(names...)
+	if err != nil {
+		return err
+	}
+
+	now := t.Now()
+	a := t.model.PositionToAngle(ss.Values[reg.PresentPosition])
+
+	vel := t.vel
+	if t.speed {
+		rpm, err := t.s.velocity(ss.Values[reg.PresentSpeed])
+		if err != nil {
+			return err
+		}
+
+		vel = rpm * 6
+	}
+
+	if t.native || !t.started {
+		t.angle, t.vel, t.time = a, vel, now
+		t.started = true
+		return nil
+	}
+
+	dt := now.Sub(t.time).Seconds()
+
+	// Where the servo should be by now, from the average of its velocity at
+	// the last sample and this one.
+	pred := t.angle + (t.vel+vel)/2*dt
+
+	angle := a + 360*math.Round((pred-a)/360)
+	if t.inDeadBand(pred) {
+		angle = pred
+	}
+
+	// Without PresentSpeed, estimate the velocity from the last two samples.
+	if !t.speed && dt > 0 {
+		vel = (angle - t.angle) / dt
+	}
+
+	t.angle, t.vel, t.time = angle, vel, now
+	return nil
+}
+
+// inDeadBand returns true if the given angle is one which PresentPosition
+// can't report, e.g. between 150 and 210 degrees on the AX-12.
+func (t *Tracker) inDeadBand(angle float64) bool {
+	m := t.model
+	if m.Range >= 360 {
+		return false
+	}
+
+	lo := m.PositionToAngle(0)
+	return math.Mod(math.Mod(angle-lo, 360)+360, 360) > m.Range
+}
+
+// Angle returns the cumulative angle of the servo in degrees, as of the last
+// Update. Counter-clockwise is positive. Zero is the center position, until
+// Reset is called.
+func (t *Tracker) Angle() float64 {
+	return t.angle - t.offset
+}
+
+// Revolutions returns the cumulative number of turns of the servo, as of the
+// last Update. See Angle.
+func (t *Tracker) Revolutions() float64 {
+	return t.Angle() / 360
+}
+
+// Velocity returns the velocity of the servo in degrees per second, as of the
+// last Update.
+func (t *Tracker) Velocity() float64 {
+	return t.vel
+}
+
+// Reset makes the current angle zero.
+func (t *Tracker) Reset() {
+	t.offset = t.angle
+}
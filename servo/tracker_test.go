@@ -0,0 +1,101 @@
+package servo
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	m := reg.Map{
+		reg.PresentPosition: {0x24, 2, reg.RO, 0, 1023, false, reg.Degrees, 0.29, reg.RAM},
+	}
+	for n, r := range wheelRegisters {
+		m[n] = r
+	}
+
+	for _, rpm := range []float64{60, -60} {
+		p, s := servo(m, map[int]byte{})
+		s.model = &Model{Steps: 1023, Range: 300, Center: 512}
+
+		tr, err := NewTracker(s)
+		assert.NoError(t, err)
+		assert.False(t, tr.Native())
+
+		clock := time.Unix(0, 0)
+		tr.Now = func() time.Time { return clock }
+
+		// Turn for three seconds (three turns), starting at the center. In the
+		// dead band, the AX-12 reports nonsense.
+		for i := 0; i <= 30; i++ {
+			angle := rpm * 6 * float64(i) / 10
+			pos := 1023
+			if a := math.Mod(math.Mod(angle+150, 360)+360, 360) - 150; a > -150 && a < 150 {
+				pos = s.model.AngleToPosition(a)
+			}
+
+			speed := int(math.Round(math.Abs(rpm) / 0.111))
+			if rpm < 0 {
+				speed |= directionBit
+			}
+
+			p.controlTable[0x24], p.controlTable[0x25] = byte(pos), byte(pos>>8)
+			p.controlTable[0x26], p.controlTable[0x27] = byte(speed), byte(speed>>8)
+
+			err = tr.Update()
+			assert.NoError(t, err)
+			assert.InDelta(t, angle, tr.Angle(), 1, "rpm=%.0f, i=%d", rpm, i)
+			clock = clock.Add(100 * time.Millisecond)
+		}
+
+		assert.InDelta(t, math.Copysign(3, rpm), tr.Revolutions(), 0.01)
+		assert.InDelta(t, rpm*6, tr.Velocity(), 1)
+
+		tr.Reset()
+		assert.Equal(t, 0.0, tr.Angle())
+	}
+}
+
+func TestTrackerNative(t *testing.T) {
+	m := reg.Map{
+		reg.PresentPosition: {0x24, 2, reg.RO, -28672, 28672, true, reg.Degrees, 0.088, reg.RAM},
+		reg.MultiTurnOffset: {0x14, 2, reg.RW, -24576, 24576, true, reg.Degrees, 0.088, reg.EEPROM},
+	}
+	for n, r := range wheelRegisters {
+		m[n] = r
+	}
+
+	m[reg.CwAngleLimit] = &reg.Register{Address: 0x06, Length: 2, Access: reg.RW, Max: 4095, Area: reg.EEPROM}
+	m[reg.CcwAngleLimit] = &reg.Register{Address: 0x08, Length: 2, Access: reg.RW, Max: 4095, Area: reg.EEPROM}
+
+	p, s := servo(m, map[int]byte{})
+	s.model = &Model{Steps: 4096, Range: 360, Center: 2048}
+
+	_, err := NewTracker(s)
+	assert.NoError(t, err)
+
+	err = s.SetMode(MultiTurn)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0x0f, 0xff, 0x0f}, p.controlTable[0x06:0x0a])
+
+	tr, err := NewTracker(s)
+	assert.NoError(t, err)
+	assert.True(t, tr.Native())
+
+	// Two and a half turns counter-clockwise of center.
+	pos := 2048 + 4096*5/2
+	p.controlTable[0x24], p.controlTable[0x25] = byte(pos), byte(pos>>8)
+
+	err = tr.Update()
+	assert.NoError(t, err)
+	assert.InDelta(t, 900, tr.Angle(), 0.001)
+	assert.InDelta(t, 2.5, tr.Revolutions(), 0.001)
+
+	// Without a known model, there's nothing to track.
+	s.model = nil
+	_, err = NewTracker(s)
+	assert.EqualError(t, err, "can't track servo of unknown model")
+}
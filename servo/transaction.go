@@ -0,0 +1,278 @@
+package servo
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/adammck/dynamixel/iface"
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Transaction collects register writes to many servos, so that they can all be
+// executed at the same time by Commit. This is useful for synchronizing the
+// movements of multiple servos, and is simpler than SetBuffered.
+//
+//	tx := servo.NewTransaction(proto)
+//	tx.Set(a, reg.GoalPosition, 100)
+//	tx.Set(b, reg.GoalPosition, 200)
+//	err := tx.Commit()
+//
+// Only RAM registers can be written, and (because each servo can only buffer
+// one instruction) the registers written to each servo must be contiguous.
+type Transaction struct {
+	proto  iface.Protocol
+	writes []txWrite
+}
+
+type txWrite struct {
+	s     *Servo
+	n     reg.RegName
+	value int
+}
+
+// block is the contiguous writes to a single servo, which are sent as one
+// instruction.
+type block struct {
+	s       *Servo
+	address int
+	params  []byte
+	writes  []txWrite
+}
+
+// NewTransaction returns an empty transaction, which will be committed via the
+// given protocol. This must be the protocol of the servos which are written,
+// and on the same bus.
+func NewTransaction(proto iface.Protocol) *Transaction {
+	return &Transaction{proto: proto}
+}
+
+// Set adds a write to the transaction. Returns an error (and doesn't add it) if
+// the register is unsupported, read-only or in EEPROM, or if the value is out
+// of range. Setting the same register of the same servo again replaces it.
+func (t *Transaction) Set(s *Servo, n reg.RegName, value int) error {
+	r, ok := s.registers[n]
+	if !ok {
+		return fmt.Errorf("can't write to unsupported register: %v", n)
+	}
+
+	if r.Access == reg.RO {
+		return fmt.Errorf("can't write to a read-only register")
+	}
+
+	if r.Area == reg.EEPROM {
+		return fmt.Errorf("can't write to EEPROM register in transaction: %v", n)
+	}
+
+	if value < r.Min {
+		return fmt.Errorf("value too low: %d (min=%d)", value, r.Min)
+	}
+
+	if value > r.Max {
+		return fmt.Errorf("value too high: %d (max=%d)", value, r.Max)
+	}
+
+	for i, w := range t.writes {
+		if w.s == s && w.n == n {
+			t.writes[i].value = value
+			return nil
+		}
+	}
+
+	t.writes = append(t.writes, txWrite{s, n, value})
+	return nil
+}
+
+// Len returns the number of writes in the transaction.
+func (t *Transaction) Len() int {
+	return len(t.writes)
+}
+
+// blocks returns the writes grouped by servo, in the order in which each servo
+// was first written.
+func (t *Transaction) blocks() ([]*block, error) {
+	var out []*block
+	byServo := map[*Servo]*block{}
+
+	for _, w := range t.writes {
+		b, ok := byServo[w.s]
+		if !ok {
+			b = &block{s: w.s}
+			byServo[w.s] = b
+			out = append(out, b)
+		}
+
+		b.writes = append(b.writes, w)
+	}
+
+	for _, b := range out {
+		m := b.s.registers
+		sort.Slice(b.writes, func(i, j int) bool {
+			return m[b.writes[i].n].Address < m[b.writes[j].n].Address
+		})
+
+		b.address = m[b.writes[0].n].Address
+		for _, w := range b.writes {
+			r := m[w.n]
+			if r.Address != b.address+len(b.params) {
+				return nil, fmt.Errorf("can't write non-contiguous registers of servo %d in one transaction", b.s.ID)
+			}
+
+			p, err := r.Encode(w.value)
+			if err != nil {
+				return nil, err
+			}
+
+			b.params = append(b.params, p...)
+		}
+	}
+
+	return out, nil
+}
+
+// Commit executes every write in the transaction at once. If the protocol
+// supports SYNC_WRITE, and every servo is written at the same address, a single
+// SYNC_WRITE instruction is sent. Otherwise, each servo is sent a REG_WRITE
+// instruction, and then they're all triggered by ACTION.
+//
+// In the latter case, if any REG_WRITE fails (or, for servos which have the
+// RegisteredInstruction register, doesn't register) then the servos which have
+// already been sent one are sent another with their current values, so that
+// nothing changes when ACTION is next sent, and an error is returned.
+//
+// The transaction is empty afterwards, unless an error is returned.
+func (t *Transaction) Commit() error {
+	if len(t.writes) == 0 {
+		return nil
+	}
+
+	blocks, err := t.blocks()
+	if err != nil {
+		return err
+	}
+
+	if sw, ok := t.proto.(iface.SyncWriter); ok && syncable(blocks) {
+		data := make(map[int][]byte, len(blocks))
+		for _, b := range blocks {
+			data[b.s.ID] = b.params
+		}
+
+		err = sw.SyncWrite(blocks[0].address, data)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = t.regWrite(blocks)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, b := range blocks {
+		if b.s.cache != nil {
+			for _, w := range b.writes {
+				b.s.cache.set(w.n, b.s.registers[w.n], w.value)
+			}
+		}
+	}
+
+	t.writes = nil
+	return nil
+}
+
+// syncable returns true if the given blocks can be sent as one SYNC_WRITE,
+// i.e. they're all the same address and length, and to different servos.
+func syncable(blocks []*block) bool {
+	ids := map[int]bool{}
+	for _, b := range blocks {
+		if b.address != blocks[0].address || len(b.params) != len(blocks[0].params) || ids[b.s.ID] {
+			return false
+		}
+
+		ids[b.s.ID] = true
+	}
+
+	return true
+}
+
+// regWrite sends the given blocks via REG_WRITE, verifies that they were
+// registered, and then sends ACTION. See Commit.
+func (t *Transaction) regWrite(blocks []*block) error {
+
+	// Read the current values first, so that we can roll back. This also
+	// ensures that every servo is responding before we send anything.
+	old := make([][]byte, len(blocks))
+	for i, b := range blocks {
+		v, err := b.s.readData(b.address, len(b.params))
+		if err != nil {
+			return fmt.Errorf("reading servo %d: %s", b.s.ID, err)
+		}
+
+		// Copy, in case the protocol reuses its buffer.
+		old[i] = append([]byte(nil), v...)
+	}
+
+	for i, b := range blocks {
+
+		// Only roll back this servo if the instruction was sent.
+		sent := i
+		err := b.regWrite(b.params)
+		if err == nil {
+			sent = i + 1
+			err = b.verify()
+		}
+
+		if err != nil {
+			rerr := rollback(blocks[:sent], old)
+			if rerr != nil {
+				return fmt.Errorf("writing servo %d: %s (and rollback failed: %s)", b.s.ID, err, rerr)
+			}
+
+			return fmt.Errorf("writing servo %d: %s", b.s.ID, err)
+		}
+	}
+
+	return t.proto.Action()
+}
+
+// rollback overwrites the instructions registered by the given blocks with
+// their old values.
+func rollback(blocks []*block, old [][]byte) error {
+	var err error
+	for i, b := range blocks {
+		e := b.regWrite(old[i])
+		if e != nil && err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// regWrite sends a REG_WRITE instruction for the block, with the given params.
+func (b *block) regWrite(params []byte) error {
+	rl, err := b.s.ReturnLevel()
+	if err != nil {
+		return err
+	}
+
+	return b.s.Protocol.RegWrite(b.s.ID, b.address, params, rl == 2)
+}
+
+// verify returns an error if the servo of the block doesn't have an instruction
+// registered. Servos without the RegisteredInstruction register are assumed to.
+func (b *block) verify() error {
+	if _, ok := b.s.registers[reg.RegisteredInstruction]; !ok {
+		return nil
+	}
+
+	v, err := b.s.getRegister(reg.RegisteredInstruction)
+	if err != nil {
+		return err
+	}
+
+	if v == 0 {
+		return fmt.Errorf("instruction was not registered")
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package servo
+
+import (
+	"errors"
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+// busProto is a mock bus of many servos, by ID.
+type busProto struct {
+	servos  map[int]*mockProto
+	actions int
+	fail    int
+}
+
+func (b *busProto) Ping(ident int) error {
+	return nil
+}
+
+func (b *busProto) ReadData(ident int, addr int, count int) ([]byte, error) {
+	return b.servos[ident].ReadData(ident, addr, count)
+}
+
+func (b *busProto) WriteData(ident int, address int, data []byte, expectResponse bool) error {
+	return b.servos[ident].WriteData(ident, address, data, expectResponse)
+}
+
+func (b *busProto) RegWrite(ident int, address int, data []byte, expectResponse bool) error {
+	if ident == b.fail {
+		return errors.New("no response")
+	}
+
+	return b.servos[ident].RegWrite(ident, address, data, expectResponse)
+}
+
+func (b *busProto) Action() error {
+	b.actions++
+	for _, p := range b.servos {
+		p.Action()
+	}
+
+	return nil
+}
+
+// syncProto is a mock bus which also supports SYNC_WRITE.
+type syncProto struct {
+	*busProto
+	syncs int
+}
+
+func (b *syncProto) SyncWrite(address int, data map[int][]byte) error {
+	b.syncs++
+	for id, d := range data {
+		b.servos[id].WriteData(id, address, d, false)
+	}
+
+	return nil
+}
+
+func TestTransaction(t *testing.T) {
+	m := reg.Map{
+		reg.CwAngleLimit: {Address: 6, Length: 2, Access: reg.RW, Max: 1023, Area: reg.EEPROM},
+		reg.GoalPosition: {Address: 30, Length: 2, Access: reg.RW, Max: 1023},
+		reg.MovingSpeed:  {Address: 32, Length: 2, Access: reg.RW, Max: 1023},
+		reg.TorqueLimit:  {Address: 34, Length: 2, Access: reg.RW, Max: 1023},
+	}
+
+	p1, s1 := servo(m, nil)
+	p2, s2 := servo(m, nil)
+	s2.ID = 2
+	bus := &busProto{servos: map[int]*mockProto{1: p1, 2: p2}}
+	s1.Protocol, s2.Protocol = bus, bus
+
+	tx := NewTransaction(bus)
+	assert.Error(t, tx.Set(s1, reg.CwAngleLimit, 1))
+	assert.Error(t, tx.Set(s1, reg.GoalPosition, 1024))
+	assert.Error(t, tx.Set(s1, reg.PresentPosition, 1))
+
+	assert.NoError(t, tx.Set(s1, reg.GoalPosition, 100))
+	assert.NoError(t, tx.Set(s1, reg.MovingSpeed, 50))
+	assert.NoError(t, tx.Set(s2, reg.GoalPosition, 200))
+	assert.NoError(t, tx.Set(s1, reg.GoalPosition, 300))
+	assert.Equal(t, 3, tx.Len())
+
+	// nothing happens until commit
+	assert.Equal(t, []byte{0, 0}, p1.controlTable[30:32])
+
+	err := tx.Commit()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, bus.actions)
+	assert.Equal(t, []byte{0x2c, 0x01, 0x32, 0x00}, p1.controlTable[30:34])
+	assert.Equal(t, []byte{0xc8, 0x00}, p2.controlTable[30:32])
+	assert.Equal(t, 0, tx.Len())
+
+	// registers must be contiguous
+	tx.Set(s1, reg.GoalPosition, 1)
+	tx.Set(s1, reg.TorqueLimit, 1)
+	assert.EqualError(t, tx.Commit(), "can't write non-contiguous registers of servo 1 in one transaction")
+	assert.Equal(t, 1, bus.actions)
+
+	// with SYNC_WRITE
+	sb := &syncProto{busProto: bus}
+	tx = NewTransaction(sb)
+	tx.Set(s1, reg.GoalPosition, 400)
+	tx.Set(s2, reg.GoalPosition, 500)
+	assert.NoError(t, tx.Commit())
+	assert.Equal(t, 1, sb.syncs)
+	assert.Equal(t, 1, bus.actions)
+	assert.Equal(t, []byte{0x90, 0x01}, p1.controlTable[30:32])
+	assert.Equal(t, []byte{0xf4, 0x01}, p2.controlTable[30:32])
+
+	// different addresses fall back to REG_WRITE
+	tx.Set(s1, reg.GoalPosition, 1)
+	tx.Set(s2, reg.MovingSpeed, 2)
+	assert.NoError(t, tx.Commit())
+	assert.Equal(t, 1, sb.syncs)
+	assert.Equal(t, 2, bus.actions)
+}
+
+func TestTransactionRollback(t *testing.T) {
+	m := reg.Map{
+		reg.GoalPosition:          {Address: 30, Length: 2, Access: reg.RW, Max: 1023},
+		reg.RegisteredInstruction: {Address: 44, Length: 1, Access: reg.RO},
+	}
+
+	p1, s1 := servo(m, map[int]byte{30: 0x10, 44: 1})
+	p2, s2 := servo(m, map[int]byte{30: 0x20, 44: 1})
+	s2.ID = 2
+	bus := &busProto{servos: map[int]*mockProto{1: p1, 2: p2}, fail: 2}
+	s1.Protocol, s2.Protocol = bus, bus
+
+	tx := NewTransaction(bus)
+	tx.Set(s1, reg.GoalPosition, 100)
+	tx.Set(s2, reg.GoalPosition, 200)
+
+	err := tx.Commit()
+	assert.EqualError(t, err, "writing servo 2: no response")
+	assert.Equal(t, 0, bus.actions)
+
+	// servo 1 now has its old value registered, so a later ACTION is harmless
+	assert.Len(t, p1.writeBuf, 2)
+	bus.Action()
+	assert.Equal(t, []byte{0x10, 0x00}, p1.controlTable[30:32])
+
+	// servos which don't register the instruction are rolled back too
+	bus.fail = 0
+	p2.controlTable[44] = 0
+	err = tx.Commit()
+	assert.EqualError(t, err, "writing servo 2: instruction was not registered")
+	bus.Action()
+	assert.Equal(t, []byte{0x10, 0x00}, p1.controlTable[30:32])
+	assert.Equal(t, []byte{0x20, 0x00}, p2.controlTable[30:32])
+}
@@ -0,0 +1,299 @@
+package servo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Older servos (e.g. the AX-12) encode velocities and loads as a magnitude in
+// the low ten bits, and a direction in bit 10, which is set for clockwise.
+const (
+	directionBit = 1 << 10
+	magnitude    = directionBit - 1
+)
+
+// SetMode switches the servo between joint mode (moving to a goal position),
+// wheel mode (turning continuously; see SetWheelVelocity) and multi-turn mode
+// (moving to a goal position up to several turns away; see Tracker). How this
+// works depends on the model:
+//
+//   - The XL-320 has a ControlMode register. It has no multi-turn mode.
+//   - Protocol 2 servos (e.g. the X-series) have an OperatingMode register.
+//     Wheel mode is VelocityControl, joint mode is PositionControl, and
+//     multi-turn mode is ExtendedPositionControl.
+//   - Older servos (e.g. the AX-12) are in wheel mode when both of their angle
+//     limits are zero. Those with a MultiTurnOffset register (e.g. the MX-28)
+//     are in multi-turn mode when both are at the maximum. The limits are saved
+//     when leaving joint mode, and restored when returning to it. They're only
+//     saved in memory, by this Servo, so if they weren't (e.g. because the mode
+//     was changed by a different Servo, or before the program was restarted),
+//     joint mode falls back to the full range. Set the angle limits afterwards
+//     to restore others.
+//
+// These are all EEPROM registers, so see SetTorqueSafe.
+func (s *Servo) SetMode(m Mode) error {
+	if m != Joint && m != Wheel && m != MultiTurn {
+		return fmt.Errorf("invalid mode: %v", m)
+	}
+
+	if _, ok := s.registers[reg.ControlMode]; ok {
+		switch m {
+		case Wheel:
+			return s.SetControlMode(WheelMode)
+		case MultiTurn:
+			return errors.New("multi-turn mode is not supported")
+		}
+
+		return s.SetControlMode(JointMode)
+	}
+
+	if _, ok := s.registers[reg.OperatingMode]; ok {
+		switch m {
+		case Wheel:
+			return s.SetOperatingMode(VelocityControl)
+		case MultiTurn:
+			return s.SetOperatingMode(ExtendedPositionControl)
+		}
+
+		return s.SetOperatingMode(PositionControl)
+	}
+
+	r, ok := s.registers[reg.CcwAngleLimit]
+	if !ok {
+		return fmt.Errorf("%v mode is not supported", m)
+	}
+
+	if _, ok := s.registers[reg.MultiTurnOffset]; m == MultiTurn && !ok {
+		return errors.New("multi-turn mode is not supported")
+	}
+
+	cw, ccw, err := s.readAngleLimits()
+	if err != nil {
+		return err
+	}
+
+	// Don't overwrite the saved limits if we've already left joint mode.
+	if s.limitsMode(cw, ccw) == Joint && m != Joint {
+		s.angleLimits = &[2]int{cw, ccw}
+	}
+
+	var limits [2]int
+	switch m {
+	case MultiTurn:
+		limits = [2]int{r.Max, r.Max}
+
+	case Joint:
+		limits = [2]int{0, r.Max}
+		if s.angleLimits != nil {
+			limits = *s.angleLimits
+		}
+	}
+
+	err = s.SetCWAngleLimit(limits[0])
+	if err != nil {
+		return err
+	}
+
+	err = s.SetCCWAngleLimit(limits[1])
+	if err != nil {
+		return err
+	}
+
+	if m == Joint {
+		s.angleLimits = nil
+	}
+
+	return nil
+}
+
+// Mode returns whether the servo is in joint, wheel or multi-turn mode. See
+// SetMode.
+func (s *Servo) Mode() (Mode, error) {
+	if _, ok := s.registers[reg.ControlMode]; ok {
+		m, err := s.ControlMode()
+		if err != nil {
+			return 0, err
+		}
+
+		if m == WheelMode {
+			return Wheel, nil
+		}
+
+		return Joint, nil
+	}
+
+	if _, ok := s.registers[reg.OperatingMode]; ok {
+		m, err := s.OperatingMode()
+		if err != nil {
+			return 0, err
+		}
+
+		switch m {
+		case VelocityControl:
+			return Wheel, nil
+		case ExtendedPositionControl:
+			return MultiTurn, nil
+		}
+
+		return Joint, nil
+	}
+
+	if _, ok := s.registers[reg.CcwAngleLimit]; !ok {
+		return Joint, nil
+	}
+
+	cw, ccw, err := s.readAngleLimits()
+	if err != nil {
+		return 0, err
+	}
+
+	return s.limitsMode(cw, ccw), nil
+}
+
+// readAngleLimits returns the values of the CwAngleLimit and CcwAngleLimit
+// registers. They're adjacent, so are read in one instruction, unless the cache
+// is enabled (in which case they're probably not read at all).
+func (s *Servo) readAngleLimits() (int, int, error) {
+	if s.cache == nil {
+		ss, err := s.Snapshot(reg.CwAngleLimit, reg.CcwAngleLimit)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return ss.Values[reg.CwAngleLimit], ss.Values[reg.CcwAngleLimit], nil
+	}
+
+	cw, err := s.CWAngleLimit()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ccw, err := s.CCWAngleLimit()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return cw, ccw, nil
+}
+
+// limitsMode returns the mode which the given angle limits select, on servos
+// which don't have a ControlMode or OperatingMode register.
+func (s *Servo) limitsMode(cw, ccw int) Mode {
+	if cw == 0 && ccw == 0 {
+		return Wheel
+	}
+
+	if _, ok := s.registers[reg.MultiTurnOffset]; ok {
+		if max := s.registers[reg.CcwAngleLimit].Max; cw == max && ccw == max {
+			return MultiTurn
+		}
+	}
+
+	return Joint
+}
+
+// velocityRegister returns the register which sets the velocity in wheel mode:
+// MovingSpeed on older servos, and GoalVelocity on newer ones.
+func (s *Servo) velocityRegister() (reg.RegName, *reg.Register, error) {
+	for _, n := range []reg.RegName{reg.MovingSpeed, reg.GoalVelocity} {
+		if r, ok := s.registers[n]; ok {
+			return n, r, nil
+		}
+	}
+
+	return 0, nil, errors.New("wheel mode is not supported")
+}
+
+// SetWheelVelocity sets the velocity of the servo in wheel mode, in RPM.
+// Positive values are counter-clockwise, and negative are clockwise. The servo
+// must be in wheel mode; see SetMode.
+func (s *Servo) SetWheelVelocity(rpm float64) error {
+	n, r, err := s.velocityRegister()
+	if err != nil {
+		return err
+	}
+
+	if r.Scale == 0 {
+		return fmt.Errorf("unknown unit of %v", n)
+	}
+
+	v := int(math.Round(rpm / r.Scale))
+
+	// Newer servos use two's complement, so the register takes care of it.
+	if r.Signed {
+		return s.setRegister(n, v)
+	}
+
+	if v > magnitude || v < -magnitude {
+		return fmt.Errorf("velocity out of range: %.2f rpm (max=%.2f)", rpm, magnitude*r.Scale)
+	}
+
+	if v < 0 {
+		v = -v | directionBit
+	}
+
+	return s.setRegister(n, v)
+}
+
+// PresentVelocity returns the current velocity of the servo, in RPM. Like
+// SetWheelVelocity, positive values are counter-clockwise.
+func (s *Servo) PresentVelocity() (float64, error) {
+	v, err := s.getRegister(reg.PresentSpeed)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.velocity(v)
+}
+
+// velocity converts a value of the PresentSpeed register to RPM.
+func (s *Servo) velocity(v int) (float64, error) {
+	r := s.registers[reg.PresentSpeed]
+	if r.Signed {
+		if r.Scale == 0 {
+			return 0, fmt.Errorf("unknown unit of %v", reg.PresentSpeed)
+		}
+
+		return r.ToPhysical(v), nil
+	}
+
+	// The scale of older servos is that of the velocity register.
+	n, vr, err := s.velocityRegister()
+	if err != nil {
+		return 0, err
+	}
+
+	if vr.Scale == 0 {
+		return 0, fmt.Errorf("unknown unit of %v", n)
+	}
+
+	return float64(signMagnitude(v)) * vr.Scale, nil
+}
+
+// PresentLoadPercent returns the current load of the servo, as a percentage of
+// its maximum torque. Positive values are counter-clockwise.
+func (s *Servo) PresentLoadPercent() (float64, error) {
+	v, err := s.getRegister(reg.PresentLoad)
+	if err != nil {
+		return 0, err
+	}
+
+	r := s.registers[reg.PresentLoad]
+	if r.Signed {
+		return r.ToPhysical(v), nil
+	}
+
+	return float64(signMagnitude(v)) * 100 / magnitude, nil
+}
+
+// signMagnitude decodes a value with the direction in bit 10.
+func signMagnitude(v int) int {
+	if v&directionBit != 0 {
+		return -(v & magnitude)
+	}
+
+	return v & magnitude
+}
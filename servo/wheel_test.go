@@ -0,0 +1,116 @@
+package servo
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+// wheelRegisters is a subset of the AX-12 control table.
+var wheelRegisters = reg.Map{
+	reg.CwAngleLimit:  {0x06, 2, reg.RW, 0, 1023, false, reg.Degrees, 0.29, reg.EEPROM},
+	reg.CcwAngleLimit: {0x08, 2, reg.RW, 0, 1023, false, reg.Degrees, 0.29, reg.EEPROM},
+	reg.MovingSpeed:   {0x20, 2, reg.RW, 0, 2047, false, reg.RPM, 0.111, reg.RAM},
+	reg.PresentSpeed:  {0x26, 2, reg.RO, 0, 2047, false, reg.None, 0, reg.RAM},
+	reg.PresentLoad:   {0x28, 2, reg.RO, 0, 2047, false, reg.None, 0, reg.RAM},
+}
+
+func TestSetMode(t *testing.T) {
+	p, s := servo(wheelRegisters, map[int]byte{
+		0x06: 10,
+		0x08: 0xf4, 0x09: 0x01, // 500
+	})
+
+	m, err := s.Mode()
+	assert.NoError(t, err)
+	assert.Equal(t, Joint, m)
+
+	err = s.SetMode(Wheel)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0}, p.controlTable[0x06:0x0a])
+
+	m, err = s.Mode()
+	assert.NoError(t, err)
+	assert.Equal(t, Wheel, m)
+
+	// Setting wheel mode again doesn't clobber the saved limits.
+	err = s.SetMode(Wheel)
+	assert.NoError(t, err)
+
+	err = s.SetMode(Joint)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{10, 0, 0xf4, 0x01}, p.controlTable[0x06:0x0a])
+
+	// Without saved limits, the full range is restored.
+	err = s.SetMode(Wheel)
+	assert.NoError(t, err)
+	s.angleLimits = nil
+	err = s.SetMode(Joint)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0xff, 0x03}, p.controlTable[0x06:0x0a])
+
+	err = s.SetMode(Mode(99))
+	assert.EqualError(t, err, "invalid mode: Mode(99)")
+}
+
+func TestSetModeControlMode(t *testing.T) {
+	p, s := servo(reg.Map{
+		reg.ControlMode: {0x0b, 1, reg.RW, 1, 2, false, reg.None, 0, reg.EEPROM},
+	}, map[int]byte{0x0b: 2})
+
+	err := s.SetMode(Wheel)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(1), p.controlTable[0x0b])
+
+	m, err := s.Mode()
+	assert.NoError(t, err)
+	assert.Equal(t, Wheel, m)
+}
+
+func TestWheelVelocity(t *testing.T) {
+	p, s := servo(wheelRegisters, map[int]byte{})
+
+	// 11.1 rpm is 100 steps, with bit 10 set for clockwise.
+	err := s.SetWheelVelocity(-11.1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{100, 0x04}, p.controlTable[0x20:0x22])
+
+	err = s.SetWheelVelocity(11.1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{100, 0}, p.controlTable[0x20:0x22])
+
+	err = s.SetWheelVelocity(200)
+	assert.EqualError(t, err, "velocity out of range: 200.00 rpm (max=113.55)")
+
+	p.controlTable[0x26] = 100
+	p.controlTable[0x27] = 0x04
+	v, err := s.PresentVelocity()
+	assert.NoError(t, err)
+	assert.InDelta(t, -11.1, v, 0.001)
+
+	p.controlTable[0x28] = 0xff
+	p.controlTable[0x29] = 0x01
+	l, err := s.PresentLoadPercent()
+	assert.NoError(t, err)
+	assert.InDelta(t, 50, l, 0.1)
+}
+
+func TestWheelVelocitySigned(t *testing.T) {
+	p, s := servo(reg.Map{
+		reg.GoalVelocity: {0x10, 4, reg.RW, -1023, 1023, true, reg.RPM, 0.229, reg.RAM},
+	}, map[int]byte{})
+
+	err := s.SetWheelVelocity(-22.9)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x9c, 0xff, 0xff, 0xff}, p.controlTable[0x10:0x14])
+
+	// Like the first PRO control table, which doesn't say what unit it's in.
+	_, s = servo(reg.Map{
+		reg.GoalVelocity: {0x10, 4, reg.RW, -1023, 1023, true, reg.RPM, 0.229, reg.RAM},
+		reg.PresentSpeed: {0x14, 4, reg.RO, 0, 0, true, reg.None, 0, reg.RAM},
+	}, map[int]byte{})
+
+	_, err = s.PresentVelocity()
+	assert.EqualError(t, err, "unknown unit of PresentSpeed")
+}
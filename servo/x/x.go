@@ -0,0 +1,175 @@
+// Package x provides the control tables of the X-series servos (XL430, XC430,
+// XM430, XM540 and XH430), which all speak protocol 2 and share one layout.
+// They differ mostly in their limits, and in whether they can sense current.
+//
+// See: https://emanual.robotis.com/docs/en/dxl/x/xm430-w350/#control-table
+package x
+
+import (
+	"io"
+
+	"github.com/adammck/dynamixel/iface"
+	"github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+)
+
+// New returns a new X-series servo with the given ID, using the given control
+// table (e.g. XM430W350).
+func New(network io.ReadWriter, registers reg.Map, ID int) (*servo.Servo, error) {
+	return servo.New(v2.New(network), registers, ID), nil
+}
+
+func NewWithReturnLevel(n iface.Protocol, registers reg.Map, ID int, returnLevel int) (*servo.Servo, error) {
+	return servo.NewWithReturnLevel(n, registers, ID, returnLevel), nil
+}
+
+// Control tables of each model. The comments are the model numbers.
+var (
+	XL430W250 reg.Map // 1060
+	XC430W150 reg.Map // 1070
+	XC430W240 reg.Map // 1080
+	XM430W210 reg.Map // 1030
+	XM430W350 reg.Map // 1020
+	XM540W150 reg.Map // 1130
+	XM540W270 reg.Map // 1120
+	XH430W210 reg.Map // 1010
+	XH430W350 reg.Map // 1000
+	XH430V210 reg.Map // 1050
+	XH430V350 reg.Map // 1040
+)
+
+// Alarms are the bits of the Shutdown and HardwareErrorStatus registers, which
+// are the same for every model which shares this layout (e.g. the MX-series
+// with protocol 2 firmware).
+var Alarms = reg.AlarmBits{reg.InputVoltage, 0, reg.Overheating, reg.MotorEncoder, reg.ElectricalShock, reg.Overload}
+
+// Indirect is the first indirect address region, which is shared by the same
+// models as Alarms. (There's a second one at 578, which isn't used.)
+var Indirect = reg.Indirect{Address: 168, Data: 224, Count: 28}
+
+// limits are the parts of the control table which vary between models.
+type limits struct {
+	minVoltage int // in 0.1V
+	maxVoltage int
+
+	// The max current, in units of about 2.69mA. Zero means that the model
+	// can't sense current, so has PresentLoad instead of PresentCurrent.
+	current int
+
+	// Whether the model has external ports (only the XM540).
+	ports bool
+}
+
+func init() {
+	XL430W250 = registers(limits{60, 140, 0, false})
+	XC430W150 = registers(limits{60, 140, 0, false})
+	XC430W240 = registers(limits{60, 140, 0, false})
+	XM430W210 = registers(limits{95, 160, 1193, false})
+	XM430W350 = registers(limits{95, 160, 1193, false})
+	XM540W150 = registers(limits{95, 160, 2047, true})
+	XM540W270 = registers(limits{95, 160, 2047, true})
+	XH430W210 = registers(limits{95, 160, 689, false})
+	XH430W350 = registers(limits{95, 160, 648, false})
+	XH430V210 = registers(limits{110, 300, 689, false})
+	XH430V350 = registers(limits{110, 300, 689, false})
+
+	for _, m := range []struct {
+		name   string
+		number int
+		r      reg.Map
+	}{
+		{"XL430-W250", 1060, XL430W250},
+		{"XC430-W150", 1070, XC430W150},
+		{"XC430-W240", 1080, XC430W240},
+		{"XM430-W210", 1030, XM430W210},
+		{"XM430-W350", 1020, XM430W350},
+		{"XM540-W150", 1130, XM540W150},
+		{"XM540-W270", 1120, XM540W270},
+		{"XH430-W210", 1010, XH430W210},
+		{"XH430-W350", 1000, XH430W350},
+		{"XH430-V210", 1050, XH430V210},
+		{"XH430-V350", 1040, XH430V350},
+	} {
+		servo.RegisterModel(&servo.Model{Name: m.name, Number: m.number, Protocol: 2, Registers: m.r, Steps: 4096, Range: 360, Center: 2048, Alarms: Alarms, Indirect: Indirect})
+	}
+}
+
+func registers(l limits) reg.Map {
+	x := 0
+	deg := 360.0 / 4096
+
+	m := reg.Map{
+
+		// EEPROM: Persisted. Can only be written while torque is disabled.
+		reg.ModelNumber:             {0, 2, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ModelInformation:        {2, 4, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.FirmwareVersion:         {6, 1, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ServoID:                 {7, 1, reg.RW, 0, 252, false, reg.None, 0, reg.EEPROM},
+		reg.BaudRate:                {8, 1, reg.RW, 0, 7, false, reg.None, 0, reg.EEPROM}, // 0=9600, 1=57600, 2=115200, 3=1M, 4=2M, 5=3M, 6=4M, 7=4.5M
+		reg.ReturnDelayTime:         {9, 1, reg.RW, 0, 254, false, reg.Microseconds, 2, reg.EEPROM},
+		reg.DriveMode:               {10, 1, reg.RW, 0, 13, false, reg.None, 0, reg.EEPROM}, // bitfield; see docs
+		reg.OperatingMode:           {11, 1, reg.RW, 0, 16, false, reg.None, 0, reg.EEPROM}, // 0=current, 1=velocity, 3=position, 4=extended position, 5=current-based position, 16=pwm
+		reg.SecondaryID:             {12, 1, reg.RW, 0, 255, false, reg.None, 0, reg.EEPROM},
+		reg.ProtocolType:            {13, 1, reg.RW, 1, 2, false, reg.None, 0, reg.EEPROM},
+		reg.HomingOffset:            {20, 4, reg.RW, -1044479, 1044479, true, reg.Degrees, deg, reg.EEPROM},
+		reg.MovingThreshold:         {24, 4, reg.RW, 0, 1023, false, reg.RPM, 0.229, reg.EEPROM},
+		reg.HighestLimitTemperature: {31, 1, reg.RW, 0, 100, false, reg.Celsius, 1, reg.EEPROM},
+		reg.HighestLimitVoltage:     {32, 2, reg.RW, l.minVoltage, l.maxVoltage, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.LowestLimitVoltage:      {34, 2, reg.RW, l.minVoltage, l.maxVoltage, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.PWMLimit:                {36, 2, reg.RW, 0, 885, false, reg.Percent, 100.0 / 885, reg.EEPROM},
+		reg.VelocityLimit:           {44, 4, reg.RW, 0, 1023, false, reg.RPM, 0.229, reg.EEPROM},
+		reg.CcwAngleLimit:           {48, 4, reg.RW, 0, 4095, false, reg.Degrees, deg, reg.EEPROM}, // Max Position Limit
+		reg.CwAngleLimit:            {52, 4, reg.RW, 0, 4095, false, reg.Degrees, deg, reg.EEPROM}, // Min Position Limit
+		reg.AlarmShutdown:           {63, 1, reg.RW, 0, 255, false, reg.None, 0, reg.EEPROM},       // Shutdown; bitfield; see docs
+
+		// RAM: Reset to default when power-cycled
+		reg.TorqueEnable:          {64, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.Led:                   {65, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.StatusReturnLevel:     {68, 1, reg.RW, 0, 2, false, reg.None, 0, reg.RAM},
+		reg.RegisteredInstruction: {69, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.HardwareErrorStatus:   {70, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.VelocityIGain:         {76, 2, reg.RW, 0, 16383, false, reg.None, 0, reg.RAM},
+		reg.VelocityPGain:         {78, 2, reg.RW, 0, 16383, false, reg.None, 0, reg.RAM},
+		reg.DGain:                 {80, 2, reg.RW, 0, 16383, false, reg.None, 0, reg.RAM}, // Position D Gain
+		reg.IGain:                 {82, 2, reg.RW, 0, 16383, false, reg.None, 0, reg.RAM}, // Position I Gain
+		reg.PGain:                 {84, 2, reg.RW, 0, 16383, false, reg.None, 0, reg.RAM}, // Position P Gain
+		reg.Feedforward2ndGain:    {88, 2, reg.RW, 0, 16383, false, reg.None, 0, reg.RAM},
+		reg.Feedforward1stGain:    {90, 2, reg.RW, 0, 16383, false, reg.None, 0, reg.RAM},
+		reg.BusWatchdog:           {98, 1, reg.RW, 0, 127, false, reg.Milliseconds, 20, reg.RAM}, // 0 = disabled
+		reg.GoalPWM:               {100, 2, reg.RW, -885, 885, true, reg.Percent, 100.0 / 885, reg.RAM},
+		reg.GoalVelocity:          {104, 4, reg.RW, -1023, 1023, true, reg.RPM, 0.229, reg.RAM},
+		reg.ProfileAcceleration:   {108, 4, reg.RW, 0, 32767, false, reg.None, 0, reg.RAM},
+		reg.ProfileVelocity:       {112, 4, reg.RW, 0, 32767, false, reg.RPM, 0.229, reg.RAM},
+		reg.GoalPosition:          {116, 4, reg.RW, -1048575, 1048575, true, reg.Degrees, deg, reg.RAM}, // 2048 (180 deg) is center
+		reg.RealtimeTick:          {120, 2, reg.RO, x, x, false, reg.Milliseconds, 1, reg.RAM},
+		reg.Moving:                {122, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.MovingStatus:          {123, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.PresentPWM:            {124, 2, reg.RO, x, x, true, reg.Percent, 100.0 / 885, reg.RAM},
+		reg.PresentSpeed:          {128, 4, reg.RO, x, x, true, reg.RPM, 0.229, reg.RAM}, // Present Velocity
+		reg.PresentPosition:       {132, 4, reg.RO, x, x, true, reg.Degrees, deg, reg.RAM},
+		reg.VelocityTrajectory:    {136, 4, reg.RO, x, x, true, reg.RPM, 0.229, reg.RAM},
+		reg.PositionTrajectory:    {140, 4, reg.RO, x, x, true, reg.Degrees, deg, reg.RAM},
+		reg.PresentVoltage:        {144, 2, reg.RO, x, x, false, reg.Volts, 0.1, reg.RAM}, // Present Input Voltage
+		reg.PresentTemperature:    {146, 1, reg.RO, x, x, false, reg.Celsius, 1, reg.RAM},
+	}
+
+	if l.current == 0 {
+		m[reg.PresentLoad] = &reg.Register{126, 2, reg.RO, x, x, true, reg.Percent, 0.1, reg.RAM}
+	} else {
+		m[reg.CurrentLimit] = &reg.Register{38, 2, reg.RW, 0, l.current, false, reg.Milliamps, 2.69, reg.EEPROM}
+		m[reg.GoalCurrent] = &reg.Register{102, 2, reg.RW, -l.current, l.current, true, reg.Milliamps, 2.69, reg.RAM}
+		m[reg.PresentCurrent] = &reg.Register{126, 2, reg.RO, x, x, true, reg.Milliamps, 2.69, reg.RAM}
+	}
+
+	if l.ports {
+		m[reg.ExternalPortMode1] = &reg.Register{56, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM}
+		m[reg.ExternalPortMode2] = &reg.Register{57, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM}
+		m[reg.ExternalPortMode3] = &reg.Register{58, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM}
+		m[reg.ExternalPortData1] = &reg.Register{152, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM}
+		m[reg.ExternalPortData2] = &reg.Register{154, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM}
+		m[reg.ExternalPortData3] = &reg.Register{156, 2, reg.RW, 0, 4095, false, reg.None, 0, reg.RAM}
+	}
+
+	return m
+}
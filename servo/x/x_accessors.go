@@ -0,0 +1,1371 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package x
+
+import (
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+)
+
+// XL430Servo wraps an XL430 or XC430 servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type XL430Servo struct {
+	s *servo.Servo
+}
+
+// WrapXL430 returns a XL430Servo wrapping the given servo.
+func WrapXL430(s *servo.Servo) *XL430Servo {
+	return &XL430Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *XL430Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *XL430Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *XL430Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *XL430Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *XL430Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *XL430Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *XL430Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *XL430Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *XL430Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *XL430Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *XL430Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *XL430Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *XL430Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *XL430Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *XL430Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *XL430Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *XL430Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *XL430Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *XL430Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *XL430Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *XL430Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *XL430Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *XL430Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *XL430Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *XL430Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *XL430Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *XL430Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *XL430Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *XL430Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentLoad returns the value of the PresentLoad register.
+func (w *XL430Servo) PresentLoad() (int, error) {
+	return w.s.PresentLoad()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *XL430Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *XL430Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *XL430Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *XL430Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// DGain returns the value of the DGain register.
+func (w *XL430Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *XL430Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *XL430Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *XL430Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *XL430Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *XL430Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *XL430Servo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *XL430Servo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *XL430Servo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (w *XL430Servo) ModelInformation() (int, error) {
+	return w.s.ModelInformation()
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (w *XL430Servo) DriveMode() (int, error) {
+	return w.s.DriveMode()
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (w *XL430Servo) SetDriveMode(v int) error {
+	return w.s.SetDriveMode(v)
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (w *XL430Servo) OperatingMode() (servo.OperatingMode, error) {
+	return w.s.OperatingMode()
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (w *XL430Servo) SetOperatingMode(v servo.OperatingMode) error {
+	return w.s.SetOperatingMode(v)
+}
+
+// SecondaryID returns the value of the SecondaryID register.
+func (w *XL430Servo) SecondaryID() (int, error) {
+	return w.s.SecondaryID()
+}
+
+// SetSecondaryID sets the value of the SecondaryID register.
+func (w *XL430Servo) SetSecondaryID(v int) error {
+	return w.s.SetSecondaryID(v)
+}
+
+// ProtocolType returns the value of the ProtocolType register.
+func (w *XL430Servo) ProtocolType() (int, error) {
+	return w.s.ProtocolType()
+}
+
+// SetProtocolType sets the value of the ProtocolType register.
+func (w *XL430Servo) SetProtocolType(v int) error {
+	return w.s.SetProtocolType(v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (w *XL430Servo) HomingOffset() (int, error) {
+	return w.s.HomingOffset()
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (w *XL430Servo) SetHomingOffset(v int) error {
+	return w.s.SetHomingOffset(v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (w *XL430Servo) MovingThreshold() (int, error) {
+	return w.s.MovingThreshold()
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (w *XL430Servo) SetMovingThreshold(v int) error {
+	return w.s.SetMovingThreshold(v)
+}
+
+// PWMLimit returns the value of the PWMLimit register.
+func (w *XL430Servo) PWMLimit() (int, error) {
+	return w.s.PWMLimit()
+}
+
+// SetPWMLimit sets the value of the PWMLimit register.
+func (w *XL430Servo) SetPWMLimit(v int) error {
+	return w.s.SetPWMLimit(v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (w *XL430Servo) VelocityLimit() (int, error) {
+	return w.s.VelocityLimit()
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (w *XL430Servo) SetVelocityLimit(v int) error {
+	return w.s.SetVelocityLimit(v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (w *XL430Servo) VelocityIGain() (int, error) {
+	return w.s.VelocityIGain()
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (w *XL430Servo) SetVelocityIGain(v int) error {
+	return w.s.SetVelocityIGain(v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (w *XL430Servo) VelocityPGain() (int, error) {
+	return w.s.VelocityPGain()
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (w *XL430Servo) SetVelocityPGain(v int) error {
+	return w.s.SetVelocityPGain(v)
+}
+
+// Feedforward2ndGain returns the value of the Feedforward2ndGain register.
+func (w *XL430Servo) Feedforward2ndGain() (int, error) {
+	return w.s.Feedforward2ndGain()
+}
+
+// SetFeedforward2ndGain sets the value of the Feedforward2ndGain register.
+func (w *XL430Servo) SetFeedforward2ndGain(v int) error {
+	return w.s.SetFeedforward2ndGain(v)
+}
+
+// Feedforward1stGain returns the value of the Feedforward1stGain register.
+func (w *XL430Servo) Feedforward1stGain() (int, error) {
+	return w.s.Feedforward1stGain()
+}
+
+// SetFeedforward1stGain sets the value of the Feedforward1stGain register.
+func (w *XL430Servo) SetFeedforward1stGain(v int) error {
+	return w.s.SetFeedforward1stGain(v)
+}
+
+// BusWatchdog returns the value of the BusWatchdog register.
+func (w *XL430Servo) BusWatchdog() (int, error) {
+	return w.s.BusWatchdog()
+}
+
+// SetBusWatchdog sets the value of the BusWatchdog register.
+func (w *XL430Servo) SetBusWatchdog(v int) error {
+	return w.s.SetBusWatchdog(v)
+}
+
+// GoalPWM returns the value of the GoalPWM register.
+func (w *XL430Servo) GoalPWM() (int, error) {
+	return w.s.GoalPWM()
+}
+
+// SetGoalPWM sets the value of the GoalPWM register.
+func (w *XL430Servo) SetGoalPWM(v int) error {
+	return w.s.SetGoalPWM(v)
+}
+
+// ProfileAcceleration returns the value of the ProfileAcceleration register.
+func (w *XL430Servo) ProfileAcceleration() (int, error) {
+	return w.s.ProfileAcceleration()
+}
+
+// SetProfileAcceleration sets the value of the ProfileAcceleration register.
+func (w *XL430Servo) SetProfileAcceleration(v int) error {
+	return w.s.SetProfileAcceleration(v)
+}
+
+// ProfileVelocity returns the value of the ProfileVelocity register.
+func (w *XL430Servo) ProfileVelocity() (int, error) {
+	return w.s.ProfileVelocity()
+}
+
+// SetProfileVelocity sets the value of the ProfileVelocity register.
+func (w *XL430Servo) SetProfileVelocity(v int) error {
+	return w.s.SetProfileVelocity(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *XL430Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// MovingStatus returns the value of the MovingStatus register.
+func (w *XL430Servo) MovingStatus() (int, error) {
+	return w.s.MovingStatus()
+}
+
+// PresentPWM returns the value of the PresentPWM register.
+func (w *XL430Servo) PresentPWM() (int, error) {
+	return w.s.PresentPWM()
+}
+
+// VelocityTrajectory returns the value of the VelocityTrajectory register.
+func (w *XL430Servo) VelocityTrajectory() (int, error) {
+	return w.s.VelocityTrajectory()
+}
+
+// PositionTrajectory returns the value of the PositionTrajectory register.
+func (w *XL430Servo) PositionTrajectory() (int, error) {
+	return w.s.PositionTrajectory()
+}
+
+// XM430Servo wraps an XM430 or XH430 servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type XM430Servo struct {
+	s *servo.Servo
+}
+
+// WrapXM430 returns a XM430Servo wrapping the given servo.
+func WrapXM430(s *servo.Servo) *XM430Servo {
+	return &XM430Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *XM430Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *XM430Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *XM430Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *XM430Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *XM430Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *XM430Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *XM430Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *XM430Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *XM430Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *XM430Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *XM430Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *XM430Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *XM430Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *XM430Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *XM430Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *XM430Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *XM430Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *XM430Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *XM430Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *XM430Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *XM430Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *XM430Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *XM430Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *XM430Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *XM430Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *XM430Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *XM430Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *XM430Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *XM430Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *XM430Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *XM430Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *XM430Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *XM430Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// DGain returns the value of the DGain register.
+func (w *XM430Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *XM430Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *XM430Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *XM430Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *XM430Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *XM430Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *XM430Servo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *XM430Servo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *XM430Servo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (w *XM430Servo) ModelInformation() (int, error) {
+	return w.s.ModelInformation()
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (w *XM430Servo) DriveMode() (int, error) {
+	return w.s.DriveMode()
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (w *XM430Servo) SetDriveMode(v int) error {
+	return w.s.SetDriveMode(v)
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (w *XM430Servo) OperatingMode() (servo.OperatingMode, error) {
+	return w.s.OperatingMode()
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (w *XM430Servo) SetOperatingMode(v servo.OperatingMode) error {
+	return w.s.SetOperatingMode(v)
+}
+
+// SecondaryID returns the value of the SecondaryID register.
+func (w *XM430Servo) SecondaryID() (int, error) {
+	return w.s.SecondaryID()
+}
+
+// SetSecondaryID sets the value of the SecondaryID register.
+func (w *XM430Servo) SetSecondaryID(v int) error {
+	return w.s.SetSecondaryID(v)
+}
+
+// ProtocolType returns the value of the ProtocolType register.
+func (w *XM430Servo) ProtocolType() (int, error) {
+	return w.s.ProtocolType()
+}
+
+// SetProtocolType sets the value of the ProtocolType register.
+func (w *XM430Servo) SetProtocolType(v int) error {
+	return w.s.SetProtocolType(v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (w *XM430Servo) HomingOffset() (int, error) {
+	return w.s.HomingOffset()
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (w *XM430Servo) SetHomingOffset(v int) error {
+	return w.s.SetHomingOffset(v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (w *XM430Servo) MovingThreshold() (int, error) {
+	return w.s.MovingThreshold()
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (w *XM430Servo) SetMovingThreshold(v int) error {
+	return w.s.SetMovingThreshold(v)
+}
+
+// PWMLimit returns the value of the PWMLimit register.
+func (w *XM430Servo) PWMLimit() (int, error) {
+	return w.s.PWMLimit()
+}
+
+// SetPWMLimit sets the value of the PWMLimit register.
+func (w *XM430Servo) SetPWMLimit(v int) error {
+	return w.s.SetPWMLimit(v)
+}
+
+// CurrentLimit returns the value of the CurrentLimit register.
+func (w *XM430Servo) CurrentLimit() (int, error) {
+	return w.s.CurrentLimit()
+}
+
+// SetCurrentLimit sets the value of the CurrentLimit register.
+func (w *XM430Servo) SetCurrentLimit(v int) error {
+	return w.s.SetCurrentLimit(v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (w *XM430Servo) VelocityLimit() (int, error) {
+	return w.s.VelocityLimit()
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (w *XM430Servo) SetVelocityLimit(v int) error {
+	return w.s.SetVelocityLimit(v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (w *XM430Servo) VelocityIGain() (int, error) {
+	return w.s.VelocityIGain()
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (w *XM430Servo) SetVelocityIGain(v int) error {
+	return w.s.SetVelocityIGain(v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (w *XM430Servo) VelocityPGain() (int, error) {
+	return w.s.VelocityPGain()
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (w *XM430Servo) SetVelocityPGain(v int) error {
+	return w.s.SetVelocityPGain(v)
+}
+
+// Feedforward2ndGain returns the value of the Feedforward2ndGain register.
+func (w *XM430Servo) Feedforward2ndGain() (int, error) {
+	return w.s.Feedforward2ndGain()
+}
+
+// SetFeedforward2ndGain sets the value of the Feedforward2ndGain register.
+func (w *XM430Servo) SetFeedforward2ndGain(v int) error {
+	return w.s.SetFeedforward2ndGain(v)
+}
+
+// Feedforward1stGain returns the value of the Feedforward1stGain register.
+func (w *XM430Servo) Feedforward1stGain() (int, error) {
+	return w.s.Feedforward1stGain()
+}
+
+// SetFeedforward1stGain sets the value of the Feedforward1stGain register.
+func (w *XM430Servo) SetFeedforward1stGain(v int) error {
+	return w.s.SetFeedforward1stGain(v)
+}
+
+// BusWatchdog returns the value of the BusWatchdog register.
+func (w *XM430Servo) BusWatchdog() (int, error) {
+	return w.s.BusWatchdog()
+}
+
+// SetBusWatchdog sets the value of the BusWatchdog register.
+func (w *XM430Servo) SetBusWatchdog(v int) error {
+	return w.s.SetBusWatchdog(v)
+}
+
+// GoalPWM returns the value of the GoalPWM register.
+func (w *XM430Servo) GoalPWM() (int, error) {
+	return w.s.GoalPWM()
+}
+
+// SetGoalPWM sets the value of the GoalPWM register.
+func (w *XM430Servo) SetGoalPWM(v int) error {
+	return w.s.SetGoalPWM(v)
+}
+
+// GoalCurrent returns the value of the GoalCurrent register.
+func (w *XM430Servo) GoalCurrent() (int, error) {
+	return w.s.GoalCurrent()
+}
+
+// SetGoalCurrent sets the value of the GoalCurrent register.
+func (w *XM430Servo) SetGoalCurrent(v int) error {
+	return w.s.SetGoalCurrent(v)
+}
+
+// ProfileAcceleration returns the value of the ProfileAcceleration register.
+func (w *XM430Servo) ProfileAcceleration() (int, error) {
+	return w.s.ProfileAcceleration()
+}
+
+// SetProfileAcceleration sets the value of the ProfileAcceleration register.
+func (w *XM430Servo) SetProfileAcceleration(v int) error {
+	return w.s.SetProfileAcceleration(v)
+}
+
+// ProfileVelocity returns the value of the ProfileVelocity register.
+func (w *XM430Servo) ProfileVelocity() (int, error) {
+	return w.s.ProfileVelocity()
+}
+
+// SetProfileVelocity sets the value of the ProfileVelocity register.
+func (w *XM430Servo) SetProfileVelocity(v int) error {
+	return w.s.SetProfileVelocity(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *XM430Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// MovingStatus returns the value of the MovingStatus register.
+func (w *XM430Servo) MovingStatus() (int, error) {
+	return w.s.MovingStatus()
+}
+
+// PresentPWM returns the value of the PresentPWM register.
+func (w *XM430Servo) PresentPWM() (int, error) {
+	return w.s.PresentPWM()
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (w *XM430Servo) PresentCurrent() (int, error) {
+	return w.s.PresentCurrent()
+}
+
+// VelocityTrajectory returns the value of the VelocityTrajectory register.
+func (w *XM430Servo) VelocityTrajectory() (int, error) {
+	return w.s.VelocityTrajectory()
+}
+
+// PositionTrajectory returns the value of the PositionTrajectory register.
+func (w *XM430Servo) PositionTrajectory() (int, error) {
+	return w.s.PositionTrajectory()
+}
+
+// XM540Servo wraps an XM540 servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type XM540Servo struct {
+	s *servo.Servo
+}
+
+// WrapXM540 returns a XM540Servo wrapping the given servo.
+func WrapXM540(s *servo.Servo) *XM540Servo {
+	return &XM540Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *XM540Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *XM540Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *XM540Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *XM540Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *XM540Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *XM540Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *XM540Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *XM540Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *XM540Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *XM540Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *XM540Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *XM540Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *XM540Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *XM540Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *XM540Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *XM540Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *XM540Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *XM540Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *XM540Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *XM540Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *XM540Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *XM540Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *XM540Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *XM540Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *XM540Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *XM540Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *XM540Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *XM540Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *XM540Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *XM540Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *XM540Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *XM540Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *XM540Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// DGain returns the value of the DGain register.
+func (w *XM540Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *XM540Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *XM540Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *XM540Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *XM540Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *XM540Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *XM540Servo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *XM540Servo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *XM540Servo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// ModelInformation returns the value of the ModelInformation register.
+func (w *XM540Servo) ModelInformation() (int, error) {
+	return w.s.ModelInformation()
+}
+
+// DriveMode returns the value of the DriveMode register.
+func (w *XM540Servo) DriveMode() (int, error) {
+	return w.s.DriveMode()
+}
+
+// SetDriveMode sets the value of the DriveMode register.
+func (w *XM540Servo) SetDriveMode(v int) error {
+	return w.s.SetDriveMode(v)
+}
+
+// OperatingMode returns the value of the OperatingMode register.
+func (w *XM540Servo) OperatingMode() (servo.OperatingMode, error) {
+	return w.s.OperatingMode()
+}
+
+// SetOperatingMode sets the value of the OperatingMode register.
+func (w *XM540Servo) SetOperatingMode(v servo.OperatingMode) error {
+	return w.s.SetOperatingMode(v)
+}
+
+// SecondaryID returns the value of the SecondaryID register.
+func (w *XM540Servo) SecondaryID() (int, error) {
+	return w.s.SecondaryID()
+}
+
+// SetSecondaryID sets the value of the SecondaryID register.
+func (w *XM540Servo) SetSecondaryID(v int) error {
+	return w.s.SetSecondaryID(v)
+}
+
+// ProtocolType returns the value of the ProtocolType register.
+func (w *XM540Servo) ProtocolType() (int, error) {
+	return w.s.ProtocolType()
+}
+
+// SetProtocolType sets the value of the ProtocolType register.
+func (w *XM540Servo) SetProtocolType(v int) error {
+	return w.s.SetProtocolType(v)
+}
+
+// HomingOffset returns the value of the HomingOffset register.
+func (w *XM540Servo) HomingOffset() (int, error) {
+	return w.s.HomingOffset()
+}
+
+// SetHomingOffset sets the value of the HomingOffset register.
+func (w *XM540Servo) SetHomingOffset(v int) error {
+	return w.s.SetHomingOffset(v)
+}
+
+// MovingThreshold returns the value of the MovingThreshold register.
+func (w *XM540Servo) MovingThreshold() (int, error) {
+	return w.s.MovingThreshold()
+}
+
+// SetMovingThreshold sets the value of the MovingThreshold register.
+func (w *XM540Servo) SetMovingThreshold(v int) error {
+	return w.s.SetMovingThreshold(v)
+}
+
+// PWMLimit returns the value of the PWMLimit register.
+func (w *XM540Servo) PWMLimit() (int, error) {
+	return w.s.PWMLimit()
+}
+
+// SetPWMLimit sets the value of the PWMLimit register.
+func (w *XM540Servo) SetPWMLimit(v int) error {
+	return w.s.SetPWMLimit(v)
+}
+
+// CurrentLimit returns the value of the CurrentLimit register.
+func (w *XM540Servo) CurrentLimit() (int, error) {
+	return w.s.CurrentLimit()
+}
+
+// SetCurrentLimit sets the value of the CurrentLimit register.
+func (w *XM540Servo) SetCurrentLimit(v int) error {
+	return w.s.SetCurrentLimit(v)
+}
+
+// VelocityLimit returns the value of the VelocityLimit register.
+func (w *XM540Servo) VelocityLimit() (int, error) {
+	return w.s.VelocityLimit()
+}
+
+// SetVelocityLimit sets the value of the VelocityLimit register.
+func (w *XM540Servo) SetVelocityLimit(v int) error {
+	return w.s.SetVelocityLimit(v)
+}
+
+// ExternalPortMode1 returns the value of the ExternalPortMode1 register.
+func (w *XM540Servo) ExternalPortMode1() (int, error) {
+	return w.s.ExternalPortMode1()
+}
+
+// SetExternalPortMode1 sets the value of the ExternalPortMode1 register.
+func (w *XM540Servo) SetExternalPortMode1(v int) error {
+	return w.s.SetExternalPortMode1(v)
+}
+
+// ExternalPortMode2 returns the value of the ExternalPortMode2 register.
+func (w *XM540Servo) ExternalPortMode2() (int, error) {
+	return w.s.ExternalPortMode2()
+}
+
+// SetExternalPortMode2 sets the value of the ExternalPortMode2 register.
+func (w *XM540Servo) SetExternalPortMode2(v int) error {
+	return w.s.SetExternalPortMode2(v)
+}
+
+// ExternalPortMode3 returns the value of the ExternalPortMode3 register.
+func (w *XM540Servo) ExternalPortMode3() (int, error) {
+	return w.s.ExternalPortMode3()
+}
+
+// SetExternalPortMode3 sets the value of the ExternalPortMode3 register.
+func (w *XM540Servo) SetExternalPortMode3(v int) error {
+	return w.s.SetExternalPortMode3(v)
+}
+
+// VelocityIGain returns the value of the VelocityIGain register.
+func (w *XM540Servo) VelocityIGain() (int, error) {
+	return w.s.VelocityIGain()
+}
+
+// SetVelocityIGain sets the value of the VelocityIGain register.
+func (w *XM540Servo) SetVelocityIGain(v int) error {
+	return w.s.SetVelocityIGain(v)
+}
+
+// VelocityPGain returns the value of the VelocityPGain register.
+func (w *XM540Servo) VelocityPGain() (int, error) {
+	return w.s.VelocityPGain()
+}
+
+// SetVelocityPGain sets the value of the VelocityPGain register.
+func (w *XM540Servo) SetVelocityPGain(v int) error {
+	return w.s.SetVelocityPGain(v)
+}
+
+// Feedforward2ndGain returns the value of the Feedforward2ndGain register.
+func (w *XM540Servo) Feedforward2ndGain() (int, error) {
+	return w.s.Feedforward2ndGain()
+}
+
+// SetFeedforward2ndGain sets the value of the Feedforward2ndGain register.
+func (w *XM540Servo) SetFeedforward2ndGain(v int) error {
+	return w.s.SetFeedforward2ndGain(v)
+}
+
+// Feedforward1stGain returns the value of the Feedforward1stGain register.
+func (w *XM540Servo) Feedforward1stGain() (int, error) {
+	return w.s.Feedforward1stGain()
+}
+
+// SetFeedforward1stGain sets the value of the Feedforward1stGain register.
+func (w *XM540Servo) SetFeedforward1stGain(v int) error {
+	return w.s.SetFeedforward1stGain(v)
+}
+
+// BusWatchdog returns the value of the BusWatchdog register.
+func (w *XM540Servo) BusWatchdog() (int, error) {
+	return w.s.BusWatchdog()
+}
+
+// SetBusWatchdog sets the value of the BusWatchdog register.
+func (w *XM540Servo) SetBusWatchdog(v int) error {
+	return w.s.SetBusWatchdog(v)
+}
+
+// GoalPWM returns the value of the GoalPWM register.
+func (w *XM540Servo) GoalPWM() (int, error) {
+	return w.s.GoalPWM()
+}
+
+// SetGoalPWM sets the value of the GoalPWM register.
+func (w *XM540Servo) SetGoalPWM(v int) error {
+	return w.s.SetGoalPWM(v)
+}
+
+// GoalCurrent returns the value of the GoalCurrent register.
+func (w *XM540Servo) GoalCurrent() (int, error) {
+	return w.s.GoalCurrent()
+}
+
+// SetGoalCurrent sets the value of the GoalCurrent register.
+func (w *XM540Servo) SetGoalCurrent(v int) error {
+	return w.s.SetGoalCurrent(v)
+}
+
+// ProfileAcceleration returns the value of the ProfileAcceleration register.
+func (w *XM540Servo) ProfileAcceleration() (int, error) {
+	return w.s.ProfileAcceleration()
+}
+
+// SetProfileAcceleration sets the value of the ProfileAcceleration register.
+func (w *XM540Servo) SetProfileAcceleration(v int) error {
+	return w.s.SetProfileAcceleration(v)
+}
+
+// ProfileVelocity returns the value of the ProfileVelocity register.
+func (w *XM540Servo) ProfileVelocity() (int, error) {
+	return w.s.ProfileVelocity()
+}
+
+// SetProfileVelocity sets the value of the ProfileVelocity register.
+func (w *XM540Servo) SetProfileVelocity(v int) error {
+	return w.s.SetProfileVelocity(v)
+}
+
+// RealtimeTick returns the value of the RealtimeTick register.
+func (w *XM540Servo) RealtimeTick() (int, error) {
+	return w.s.RealtimeTick()
+}
+
+// MovingStatus returns the value of the MovingStatus register.
+func (w *XM540Servo) MovingStatus() (int, error) {
+	return w.s.MovingStatus()
+}
+
+// PresentPWM returns the value of the PresentPWM register.
+func (w *XM540Servo) PresentPWM() (int, error) {
+	return w.s.PresentPWM()
+}
+
+// PresentCurrent returns the value of the PresentCurrent register.
+func (w *XM540Servo) PresentCurrent() (int, error) {
+	return w.s.PresentCurrent()
+}
+
+// VelocityTrajectory returns the value of the VelocityTrajectory register.
+func (w *XM540Servo) VelocityTrajectory() (int, error) {
+	return w.s.VelocityTrajectory()
+}
+
+// PositionTrajectory returns the value of the PositionTrajectory register.
+func (w *XM540Servo) PositionTrajectory() (int, error) {
+	return w.s.PositionTrajectory()
+}
+
+// ExternalPortData1 returns the value of the ExternalPortData1 register.
+func (w *XM540Servo) ExternalPortData1() (int, error) {
+	return w.s.ExternalPortData1()
+}
+
+// SetExternalPortData1 sets the value of the ExternalPortData1 register.
+func (w *XM540Servo) SetExternalPortData1(v int) error {
+	return w.s.SetExternalPortData1(v)
+}
+
+// ExternalPortData2 returns the value of the ExternalPortData2 register.
+func (w *XM540Servo) ExternalPortData2() (int, error) {
+	return w.s.ExternalPortData2()
+}
+
+// SetExternalPortData2 sets the value of the ExternalPortData2 register.
+func (w *XM540Servo) SetExternalPortData2(v int) error {
+	return w.s.SetExternalPortData2(v)
+}
+
+// ExternalPortData3 returns the value of the ExternalPortData3 register.
+func (w *XM540Servo) ExternalPortData3() (int, error) {
+	return w.s.ExternalPortData3()
+}
+
+// SetExternalPortData3 sets the value of the ExternalPortData3 register.
+func (w *XM540Servo) SetExternalPortData3(v int) error {
+	return w.s.SetExternalPortData3(v)
+}
@@ -0,0 +1,36 @@
+package x
+
+import (
+	"testing"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisters(t *testing.T) {
+	for _, m := range []reg.Map{XL430W250, XC430W150, XC430W240, XM430W210, XM430W350, XM540W150, XM540W270, XH430W210, XH430W350, XH430V210, XH430V350} {
+		used := map[int]reg.RegName{}
+
+		for n, r := range m {
+			assert.Contains(t, []int{1, 2, 4}, r.Length, "%s", n)
+			assert.True(t, r.Min <= r.Max, "%s", n)
+
+			for i := 0; i < r.Length; i++ {
+				a := r.Address + i
+				other, ok := used[a]
+				assert.False(t, ok, "%s overlaps %s at %d", n, other, a)
+				used[a] = n
+			}
+		}
+	}
+}
+
+func TestCurrent(t *testing.T) {
+	assert.Contains(t, XM430W350, reg.PresentCurrent)
+	assert.NotContains(t, XM430W350, reg.PresentLoad)
+	assert.Equal(t, -1193, XM430W350[reg.GoalCurrent].Min)
+
+	assert.Contains(t, XL430W250, reg.PresentLoad)
+	assert.NotContains(t, XL430W250, reg.PresentCurrent)
+	assert.NotContains(t, XL430W250, reg.GoalCurrent)
+}
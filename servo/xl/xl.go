@@ -21,44 +21,50 @@ func NewWithReturnLevel(n iface.Protocol, ID int, returnLevel int) (*servo.Servo
 
 var Registers reg.Map
 
+// Alarms are the bits of the AlarmShutdown and HardwareErrorStatus registers.
+var Alarms = reg.AlarmBits{reg.Overload, reg.Overheating, reg.InputVoltage}
+
 func init() {
 	x := 0
+	deg := 300.0 / 1023
 
 	Registers = reg.Map{
 
 		// EEPROM: Persisted
-		reg.ModelNumber:             {0x00, 2, reg.RO, x, x},
-		reg.FirmwareVersion:         {0x02, 1, reg.RO, x, x},
-		reg.ServoID:                 {0x03, 1, reg.RW, 0, 252}, // renamed from ID for clarity
-		reg.BaudRate:                {0x04, 1, reg.RW, 0, 3},   // 0=9600, 1=57600, 2=115200, 3=1Mbps
-		reg.ReturnDelayTime:         {0x05, 1, reg.RW, 0, 254}, // usec = value*2
-		reg.CwAngleLimit:            {0x06, 2, reg.RW, 0, 1023},
-		reg.CcwAngleLimit:           {0x08, 2, reg.RW, 0, 1023},
-		reg.ControlMode:             {0x0b, 1, reg.RW, 1, 2},    // 1=wheel mode, 2=joint mode
-		reg.HighestLimitTemperature: {0x0c, 1, reg.RW, 0, 150},  // docs says not to set
-		reg.LowestLimitVoltage:      {0x0d, 1, reg.RW, 50, 250}, // volt = value*0.1
-		reg.HighestLimitVoltage:     {0x0e, 1, reg.RW, 50, 250}, // volt = value*0.1
-		reg.MaxTorque:               {0x0f, 2, reg.RW, 0, 1023}, // from zero to max torque
-		reg.StatusReturnLevel:       {0x11, 1, reg.RW, 0, 2},    // enum; see docs
-		reg.AlarmShutdown:           {0x12, 1, reg.RW, 0, 256},  // enum; see docs
+		reg.ModelNumber:             {0x00, 2, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.FirmwareVersion:         {0x02, 1, reg.RO, x, x, false, reg.None, 0, reg.EEPROM},
+		reg.ServoID:                 {0x03, 1, reg.RW, 0, 252, false, reg.None, 0, reg.EEPROM}, // renamed from ID for clarity
+		reg.BaudRate:                {0x04, 1, reg.RW, 0, 3, false, reg.None, 0, reg.EEPROM},   // 0=9600, 1=57600, 2=115200, 3=1Mbps
+		reg.ReturnDelayTime:         {0x05, 1, reg.RW, 0, 254, false, reg.Microseconds, 2, reg.EEPROM},
+		reg.CwAngleLimit:            {0x06, 2, reg.RW, 0, 1023, false, reg.Degrees, deg, reg.EEPROM},
+		reg.CcwAngleLimit:           {0x08, 2, reg.RW, 0, 1023, false, reg.Degrees, deg, reg.EEPROM},
+		reg.ControlMode:             {0x0b, 1, reg.RW, 1, 2, false, reg.None, 0, reg.EEPROM},      // 1=wheel mode, 2=joint mode
+		reg.HighestLimitTemperature: {0x0c, 1, reg.RW, 0, 150, false, reg.Celsius, 1, reg.EEPROM}, // docs says not to set
+		reg.LowestLimitVoltage:      {0x0d, 1, reg.RW, 50, 250, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.HighestLimitVoltage:     {0x0e, 1, reg.RW, 50, 250, false, reg.Volts, 0.1, reg.EEPROM},
+		reg.MaxTorque:               {0x0f, 2, reg.RW, 0, 1023, false, reg.Percent, 100.0 / 1023, reg.EEPROM}, // from zero to max torque
+		reg.StatusReturnLevel:       {0x11, 1, reg.RW, 0, 2, false, reg.None, 0, reg.EEPROM},                  // enum; see docs
+		reg.AlarmShutdown:           {0x12, 1, reg.RW, 0, 7, false, reg.None, 0, reg.EEPROM},                  // enum; see docs
 
 		// RAM: Reset to default when power-cycled
-		reg.TorqueEnable:          {0x18, 1, reg.RW, 0, 1},
-		reg.Led:                   {0x19, 1, reg.RW, 0, 7},
-		reg.DGain:                 {0x1b, 1, reg.RW, 0, 254},
-		reg.IGain:                 {0x1c, 1, reg.RW, 0, 254},
-		reg.PGain:                 {0x1d, 1, reg.RW, 0, 1023},
-		reg.GoalPosition:          {0x1e, 2, reg.RW, 0, 1023}, // deg = value*0.29; 512 (150 deg) is center
-		reg.GoalVelocity:          {0x20, 2, reg.RW, 0, 2047}, // joint mode: rpm = ~value*0.111, but 0 = max rpm. wheel mode: see docs
-		reg.GoalTorque:            {0x23, 2, reg.RW, 0, 1023}, // zero to max torque
-		reg.PresentPosition:       {0x25, 2, reg.RO, x, x},    // like goalPosition
-		reg.PresentSpeed:          {0x27, 2, reg.RO, x, x},
-		reg.PresentLoad:           {0x29, 2, reg.RO, x, x},
-		reg.PresentVoltage:        {0x2d, 1, reg.RO, x, x},
-		reg.PresentTemperature:    {0x2e, 1, reg.RO, x, x},
-		reg.RegisteredInstruction: {0x2f, 1, reg.RO, x, x},
-		reg.Moving:                {0x31, 1, reg.RO, x, x},
-		reg.HardwareErrorStatus:   {0x32, 1, reg.RO, x, x},
-		reg.Punch:                 {0x33, 2, reg.RW, 32, 1023},
+		reg.TorqueEnable:          {0x18, 1, reg.RW, 0, 1, false, reg.None, 0, reg.RAM},
+		reg.Led:                   {0x19, 1, reg.RW, 0, 7, false, reg.None, 0, reg.RAM},
+		reg.DGain:                 {0x1b, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},
+		reg.IGain:                 {0x1c, 1, reg.RW, 0, 254, false, reg.None, 0, reg.RAM},
+		reg.PGain:                 {0x1d, 1, reg.RW, 0, 1023, false, reg.None, 0, reg.RAM},
+		reg.GoalPosition:          {0x1e, 2, reg.RW, 0, 1023, false, reg.Degrees, deg, reg.RAM},          // 512 (150 deg) is center
+		reg.GoalVelocity:          {0x20, 2, reg.RW, 0, 2047, false, reg.RPM, 0.111, reg.RAM},            // joint mode: 0 = max rpm. wheel mode: see docs
+		reg.GoalTorque:            {0x23, 2, reg.RW, 0, 1023, false, reg.Percent, 100.0 / 1023, reg.RAM}, // zero to max torque
+		reg.PresentPosition:       {0x25, 2, reg.RO, x, x, false, reg.Degrees, deg, reg.RAM},             // like goalPosition
+		reg.PresentSpeed:          {0x27, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM},                  // bit 10 is direction, so no unit
+		reg.PresentLoad:           {0x29, 2, reg.RO, x, x, false, reg.None, 0, reg.RAM},                  // bit 10 is direction, so no unit
+		reg.PresentVoltage:        {0x2d, 1, reg.RO, x, x, false, reg.Volts, 0.1, reg.RAM},
+		reg.PresentTemperature:    {0x2e, 1, reg.RO, x, x, false, reg.Celsius, 1, reg.RAM},
+		reg.RegisteredInstruction: {0x2f, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.Moving:                {0x31, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.HardwareErrorStatus:   {0x32, 1, reg.RO, x, x, false, reg.None, 0, reg.RAM},
+		reg.Punch:                 {0x33, 2, reg.RW, 32, 1023, false, reg.None, 0, reg.RAM},
 	}
+
+	servo.RegisterModel(&servo.Model{Name: "XL-320", Number: 350, Protocol: 2, Registers: Registers, Steps: 1023, Range: 300, Center: 512, Alarms: Alarms})
 }
@@ -0,0 +1,279 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package xl
+
+import (
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+)
+
+// Servo wraps an XL-320 servo, with accessors for only the registers which
+// it has, so that using any other register is a compile error.
+type Servo struct {
+	s *servo.Servo
+}
+
+// Wrap returns a Servo wrapping the given servo.
+func Wrap(s *servo.Servo) *Servo {
+	return &Servo{s}
+}
+
+// Servo returns the wrapped servo.
+func (w *Servo) Servo() *servo.Servo {
+	return w.s
+}
+
+// ModelNumber returns the value of the ModelNumber register.
+func (w *Servo) ModelNumber() (int, error) {
+	return w.s.ModelNumber()
+}
+
+// FirmwareVersion returns the value of the FirmwareVersion register.
+func (w *Servo) FirmwareVersion() (int, error) {
+	return w.s.FirmwareVersion()
+}
+
+// ServoID returns the value of the ServoID register.
+func (w *Servo) ServoID() (int, error) {
+	return w.s.ServoID()
+}
+
+// SetServoID changes the identity of the servo.
+// This is stored in EEPROM, so will persist between reboots.
+func (w *Servo) SetServoID(v int) error {
+	return w.s.SetServoID(v)
+}
+
+// BaudRate returns the value of the BaudRate register.
+func (w *Servo) BaudRate() (int, error) {
+	return w.s.BaudRate()
+}
+
+// SetBaudRate sets the value of the BaudRate register.
+func (w *Servo) SetBaudRate(v int) error {
+	return w.s.SetBaudRate(v)
+}
+
+// ReturnDelayTime returns the value of the ReturnDelayTime register.
+func (w *Servo) ReturnDelayTime() (int, error) {
+	return w.s.ReturnDelayTime()
+}
+
+// SetReturnDelayTime sets the value of the ReturnDelayTime register.
+func (w *Servo) SetReturnDelayTime(v int) error {
+	return w.s.SetReturnDelayTime(v)
+}
+
+// CWAngleLimit returns the value of the CwAngleLimit register.
+func (w *Servo) CWAngleLimit() (int, error) {
+	return w.s.CWAngleLimit()
+}
+
+// SetCWAngleLimit sets the value of the CwAngleLimit register.
+func (w *Servo) SetCWAngleLimit(v int) error {
+	return w.s.SetCWAngleLimit(v)
+}
+
+// CCWAngleLimit returns the value of the CcwAngleLimit register.
+func (w *Servo) CCWAngleLimit() (int, error) {
+	return w.s.CCWAngleLimit()
+}
+
+// SetCCWAngleLimit sets the value of the CcwAngleLimit register.
+func (w *Servo) SetCCWAngleLimit(v int) error {
+	return w.s.SetCCWAngleLimit(v)
+}
+
+// HighestLimitTemperature returns the value of the HighestLimitTemperature register.
+func (w *Servo) HighestLimitTemperature() (int, error) {
+	return w.s.HighestLimitTemperature()
+}
+
+// SetHighestLimitTemperature sets the value of the HighestLimitTemperature register.
+func (w *Servo) SetHighestLimitTemperature(v int) error {
+	return w.s.SetHighestLimitTemperature(v)
+}
+
+// LowestLimitVoltage returns the value of the LowestLimitVoltage register.
+func (w *Servo) LowestLimitVoltage() (int, error) {
+	return w.s.LowestLimitVoltage()
+}
+
+// SetLowestLimitVoltage sets the value of the LowestLimitVoltage register.
+func (w *Servo) SetLowestLimitVoltage(v int) error {
+	return w.s.SetLowestLimitVoltage(v)
+}
+
+// HighestLimitVoltage returns the value of the HighestLimitVoltage register.
+func (w *Servo) HighestLimitVoltage() (int, error) {
+	return w.s.HighestLimitVoltage()
+}
+
+// SetHighestLimitVoltage sets the value of the HighestLimitVoltage register.
+func (w *Servo) SetHighestLimitVoltage(v int) error {
+	return w.s.SetHighestLimitVoltage(v)
+}
+
+// MaxTorque returns the value of the MaxTorque register.
+func (w *Servo) MaxTorque() (int, error) {
+	return w.s.MaxTorque()
+}
+
+// SetMaxTorque sets the value of the MaxTorque register.
+func (w *Servo) SetMaxTorque(v int) error {
+	return w.s.SetMaxTorque(v)
+}
+
+// AlarmShutdown returns the value of the AlarmShutdown register.
+func (w *Servo) AlarmShutdown() (reg.AlarmFlags, error) {
+	return w.s.AlarmShutdown()
+}
+
+// SetAlarmShutdown sets the value of the AlarmShutdown register.
+func (w *Servo) SetAlarmShutdown(v reg.AlarmFlags) error {
+	return w.s.SetAlarmShutdown(v)
+}
+
+// TorqueEnable returns the value of the TorqueEnable register.
+func (w *Servo) TorqueEnable() (bool, error) {
+	return w.s.TorqueEnable()
+}
+
+// SetTorqueEnable sets the value of the TorqueEnable register.
+func (w *Servo) SetTorqueEnable(v bool) error {
+	return w.s.SetTorqueEnable(v)
+}
+
+// LED returns the value of the Led register.
+func (w *Servo) LED() (bool, error) {
+	return w.s.LED()
+}
+
+// SetLED sets the value of the Led register.
+func (w *Servo) SetLED(v bool) error {
+	return w.s.SetLED(v)
+}
+
+// GoalPosition returns the value of the GoalPosition register.
+func (w *Servo) GoalPosition() (int, error) {
+	return w.s.GoalPosition()
+}
+
+// SetGoalPosition sets the goal position.
+//
+// TODO: Reject if the servo is in wheel mode (where CW and CCW angle limit
+//
+//	is zero).
+func (w *Servo) SetGoalPosition(v int) error {
+	return w.s.SetGoalPosition(v)
+}
+
+// PresentPosition returns the value of the PresentPosition register.
+func (w *Servo) PresentPosition() (int, error) {
+	return w.s.PresentPosition()
+}
+
+// PresentSpeed returns the value of the PresentSpeed register.
+func (w *Servo) PresentSpeed() (int, error) {
+	return w.s.PresentSpeed()
+}
+
+// PresentLoad returns the value of the PresentLoad register.
+func (w *Servo) PresentLoad() (int, error) {
+	return w.s.PresentLoad()
+}
+
+// PresentVoltage returns the value of the PresentVoltage register.
+func (w *Servo) PresentVoltage() (int, error) {
+	return w.s.PresentVoltage()
+}
+
+// PresentTemperature returns the value of the PresentTemperature register.
+func (w *Servo) PresentTemperature() (int, error) {
+	return w.s.PresentTemperature()
+}
+
+// RegisteredInstruction returns the value of the RegisteredInstruction register.
+func (w *Servo) RegisteredInstruction() (bool, error) {
+	return w.s.RegisteredInstruction()
+}
+
+// Moving returns the value of the Moving register.
+func (w *Servo) Moving() (bool, error) {
+	return w.s.Moving()
+}
+
+// Punch returns the value of the Punch register.
+func (w *Servo) Punch() (int, error) {
+	return w.s.Punch()
+}
+
+// SetPunch sets the value of the Punch register.
+func (w *Servo) SetPunch(v int) error {
+	return w.s.SetPunch(v)
+}
+
+// ControlMode returns the value of the ControlMode register.
+func (w *Servo) ControlMode() (servo.ControlMode, error) {
+	return w.s.ControlMode()
+}
+
+// SetControlMode sets the value of the ControlMode register.
+func (w *Servo) SetControlMode(v servo.ControlMode) error {
+	return w.s.SetControlMode(v)
+}
+
+// DGain returns the value of the DGain register.
+func (w *Servo) DGain() (int, error) {
+	return w.s.DGain()
+}
+
+// SetDGain sets the value of the DGain register.
+func (w *Servo) SetDGain(v int) error {
+	return w.s.SetDGain(v)
+}
+
+// IGain returns the value of the IGain register.
+func (w *Servo) IGain() (int, error) {
+	return w.s.IGain()
+}
+
+// SetIGain sets the value of the IGain register.
+func (w *Servo) SetIGain(v int) error {
+	return w.s.SetIGain(v)
+}
+
+// PGain returns the value of the PGain register.
+func (w *Servo) PGain() (int, error) {
+	return w.s.PGain()
+}
+
+// SetPGain sets the value of the PGain register.
+func (w *Servo) SetPGain(v int) error {
+	return w.s.SetPGain(v)
+}
+
+// HardwareErrorStatus returns the value of the HardwareErrorStatus register.
+func (w *Servo) HardwareErrorStatus() (reg.AlarmFlags, error) {
+	return w.s.HardwareErrorStatus()
+}
+
+// GoalVelocity returns the value of the GoalVelocity register.
+func (w *Servo) GoalVelocity() (int, error) {
+	return w.s.GoalVelocity()
+}
+
+// SetGoalVelocity sets the value of the GoalVelocity register.
+func (w *Servo) SetGoalVelocity(v int) error {
+	return w.s.SetGoalVelocity(v)
+}
+
+// GoalTorque returns the value of the GoalTorque register.
+func (w *Servo) GoalTorque() (int, error) {
+	return w.s.GoalTorque()
+}
+
+// SetGoalTorque sets the value of the GoalTorque register.
+func (w *Servo) SetGoalTorque(v int) error {
+	return w.s.SetGoalTorque(v)
+}
@@ -0,0 +1,57 @@
+package simulator
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for a simulated bus. The servos move, heat up,
+// and respond to instructions according to it.
+type Clock interface {
+	Now() time.Time
+
+	// Sleep blocks until the given duration has passed on this clock.
+	Sleep(d time.Duration)
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// VirtualClock is a Clock which only moves when it's told to, which makes it
+// possible to write deterministic tests of movement. Sleeping on it advances it
+// immediately, so waiting for a status packet doesn't actually wait.
+type VirtualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewVirtualClock returns a virtual clock, stopped at an arbitrary time.
+func NewVirtualClock() *VirtualClock {
+	return &VirtualClock{
+		now: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (c *VirtualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *VirtualClock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+// Advance moves the clock forwards by the given duration.
+func (c *VirtualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
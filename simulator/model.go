@@ -0,0 +1,140 @@
+package simulator
+
+import (
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/adammck/dynamixel/servo/xl"
+)
+
+// Model describes a kind of servo which can be simulated: which protocol it
+// speaks, the layout of its control table, and the factory default values.
+type Model struct {
+	Name      string
+	Protocol  int
+	Registers reg.Map
+
+	// The address at which the RAM area of the control table begins. Everything
+	// below this is EEPROM, which survives a reboot.
+	RAM int
+
+	// Whether the EEPROM area is locked while torque is enabled, as it is on
+	// newer models.
+	LockEEPROM bool
+
+	// The value of each register immediately after a factory reset. Registers
+	// which are not present default to zero.
+	Defaults map[reg.RegName]int
+
+	// The number of positions, and the angle (in degrees) which they span.
+	Steps int
+	Range float64
+
+	// The speed (in rpm) with no load, and the torque (in N*m) at which the
+	// motor stalls.
+	MaxRPM      float64
+	StallTorque float64
+
+	// The maximum acceleration, in rpm per second.
+	Accel float64
+
+	// The speed (in rpm) represented by one unit of the MovingSpeed and
+	// PresentSpeed registers.
+	SpeedUnit float64
+
+	// The bits of the AlarmShutdown register (and friends) which represent each
+	// error condition, if they differ from the protocol 1 error byte.
+	Alarms reg.AlarmBits
+}
+
+// size returns the number of bytes in the control table.
+func (m *Model) size() int {
+	n := 0
+	for _, r := range m.Registers {
+		if end := r.Address + r.Length; end > n {
+			n = end
+		}
+	}
+
+	return n
+}
+
+// AX12 is the AX-12(A), which speaks protocol 1.
+// See: http://support.robotis.com/en/product/dynamixel/ax_series/dxl_ax_actuator.htm
+var AX12 = &Model{
+	Name:      "AX-12",
+	Protocol:  1,
+	Registers: ax.Registers,
+	RAM:       0x18,
+	Defaults: map[reg.RegName]int{
+		reg.ModelNumber:             12,
+		reg.FirmwareVersion:         24,
+		reg.ServoID:                 1,
+		reg.BaudRate:                1,
+		reg.ReturnDelayTime:         250,
+		reg.CwAngleLimit:            0,
+		reg.CcwAngleLimit:           1023,
+		reg.HighestLimitTemperature: 70,
+		reg.LowestLimitVoltage:      60,
+		reg.HighestLimitVoltage:     140,
+		reg.MaxTorque:               1023,
+		reg.StatusReturnLevel:       2,
+		reg.AlarmLed:                36,
+		reg.AlarmShutdown:           36,
+		reg.CwComplianceMargin:      1,
+		reg.CcwComplianceMargin:     1,
+		reg.CwComplianceSlope:       32,
+		reg.CcwComplianceSlope:      32,
+		reg.GoalPosition:            512,
+		reg.TorqueLimit:             1023,
+		reg.PresentPosition:         512,
+		reg.PresentVoltage:          110,
+		reg.PresentTemperature:      25,
+		reg.Punch:                   32,
+	},
+	Steps:       1024,
+	Range:       300,
+	MaxRPM:      59,
+	StallTorque: 1.5,
+	Accel:       1000,
+	SpeedUnit:   0.111,
+}
+
+// XL320 is the XL-320, which speaks protocol 2.
+// See: http://support.robotis.com/en/product/dynamixel/xl-series/xl-320.htm
+var XL320 = &Model{
+	Name:       "XL-320",
+	Protocol:   2,
+	Registers:  xl.Registers,
+	RAM:        0x18,
+	LockEEPROM: true,
+	Defaults: map[reg.RegName]int{
+		reg.ModelNumber:             350,
+		reg.FirmwareVersion:         29,
+		reg.ServoID:                 1,
+		reg.BaudRate:                3,
+		reg.ReturnDelayTime:         250,
+		reg.CwAngleLimit:            0,
+		reg.CcwAngleLimit:           1023,
+		reg.ControlMode:             2,
+		reg.HighestLimitTemperature: 65,
+		reg.LowestLimitVoltage:      60,
+		reg.HighestLimitVoltage:     90,
+		reg.MaxTorque:               1023,
+		reg.StatusReturnLevel:       2,
+		reg.AlarmShutdown:           3,
+		reg.PGain:                   32,
+		reg.GoalPosition:            512,
+		reg.GoalTorque:              1023,
+		reg.PresentPosition:         512,
+		reg.PresentVoltage:          74,
+		reg.PresentTemperature:      25,
+		reg.Punch:                   32,
+	},
+	Steps:       1024,
+	Range:       300,
+	MaxRPM:      114,
+	StallTorque: 0.39,
+	Accel:       1000,
+	SpeedUnit:   0.111,
+	Alarms:      xl.Alarms,
+}
@@ -0,0 +1,239 @@
+package simulator
+
+import (
+	"math"
+
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Load is a function which returns the external torque (in N*m) acting on the
+// horn of a simulated servo, given its angle (in degrees from position zero)
+// and velocity (in rpm). Positive torques push towards higher positions.
+type Load func(angle, rpm float64) float64
+
+const (
+
+	// The temperature (in C) which servos cool down to.
+	ambient = 25.0
+
+	// The rate (in C per second) at which a servo heats up at stall torque. This
+	// scales with the square of the load, like the current through the motor.
+	heatRate = 0.5
+
+	// The time constant (in seconds) of a servo cooling towards ambient.
+	coolTime = 120.0
+)
+
+// step simulates the servo for dt seconds. This is a very rough model: the
+// servo accelerates towards the goal position at a constant rate, up to the
+// moving speed, but slows down (linearly, like a DC motor) as the torque which
+// it must exert against the external load approaches the torque limit. In
+// wheel mode, it accelerates towards the moving speed (in either direction)
+// instead, and turns continuously.
+func (s *Servo) step(dt float64) {
+	m := s.model
+	if m.Steps == 0 || m.Range == 0 {
+		return
+	}
+
+	perRPM := float64(m.Steps) / m.Range * 6 // positions per second
+	torque := s.get(reg.TorqueEnable) != 0
+	avail := m.StallTorque * float64(s.torqueLimit()) / 1023
+
+	ext := 0.0
+	if s.load != nil {
+		ext = s.load(s.pos*m.Range/float64(m.Steps), s.vel/perRPM)
+	}
+
+	// The fraction of the available torque which the motor must exert just to
+	// hold its position against the load.
+	s.effort = 0
+	if torque && ext != 0 {
+		s.effort = -1
+		if avail > 0 {
+			s.effort = -ext / avail
+		}
+	}
+
+	overload := math.Abs(s.effort) >= 1
+	s.effort = math.Max(-1, math.Min(1, s.effort))
+
+	wheel := s.wheel()
+	lo, hi := s.limits()
+	goal := math.Max(lo, math.Min(hi, float64(s.get(reg.GoalPosition))))
+	accel := m.Accel * perRPM
+
+	want := 0.0
+	if torque && !overload {
+		if wheel {
+			want = s.wheelSpeed() * perRPM * (1 - math.Abs(s.effort))
+		} else {
+			max := s.speedLimit() * perRPM * (1 - math.Abs(s.effort))
+			err := goal - s.pos
+			want = math.Copysign(math.Min(max, math.Sqrt(2*accel*math.Abs(err))), err)
+		}
+	}
+
+	dv := math.Max(-accel*dt, math.Min(accel*dt, want-s.vel))
+	s.vel += dv
+
+	prev := s.pos
+	s.pos += s.vel * dt
+
+	if wheel {
+
+		// Wrap around after a whole turn, which (if the positions don't span
+		// one) includes some angles which have no position.
+		turn := float64(m.Steps) / m.Range * 360
+		s.pos = math.Mod(math.Mod(s.pos, turn)+turn, turn)
+
+	} else {
+
+		// Don't overshoot the goal, or go past the angle limits.
+		if torque && (goal-prev)*(goal-s.pos) <= 0 {
+			s.pos = goal
+			s.vel = 0
+		}
+		if s.pos < lo || s.pos > hi {
+			s.pos = math.Max(lo, math.Min(hi, s.pos))
+			s.vel = 0
+		}
+	}
+
+	s.temp += (heatRate*s.effort*s.effort - (s.temp-ambient)/coolTime) * dt
+
+	s.conds = 0
+	if overload {
+		s.conds |= reg.Overload
+	}
+
+	s.sync()
+	s.alarm()
+}
+
+// sync updates the Present* registers from the physical state.
+func (s *Servo) sync() {
+	m := s.model
+	if m.Steps == 0 || m.Range == 0 {
+		return
+	}
+
+	// Angles between the last position and the first (in wheel mode) are
+	// reported as the last.
+	s.set(reg.PresentPosition, int(math.Round(math.Min(s.pos, float64(m.Steps-1)))))
+	s.set(reg.PresentTemperature, int(math.Round(s.temp)))
+
+	rpm := s.vel / (float64(m.Steps) / m.Range * 6)
+	s.set(reg.PresentSpeed, signMagnitude(rpm/m.SpeedUnit))
+	s.set(reg.PresentLoad, signMagnitude(s.effort*1023))
+
+	moving := 0
+	if s.vel != 0 {
+		moving = 1
+	}
+
+	s.set(reg.Moving, moving)
+}
+
+// alarm checks the error conditions, and shuts down the motor if any of them
+// are configured to do so. If the model has a HardwareErrorStatus register,
+// errors are latched there until the servo is rebooted.
+func (s *Servo) alarm() {
+	v := s.get(reg.PresentVoltage)
+	if v < s.get(reg.LowestLimitVoltage) || v > s.get(reg.HighestLimitVoltage) {
+		s.conds |= reg.InputVoltage
+	}
+
+	if s.get(reg.PresentTemperature) > s.get(reg.HighestLimitTemperature) {
+		s.conds |= reg.Overheating
+	}
+
+	// Every model has bits for the conditions which are simulated.
+	active, _ := s.model.Alarms.Encode(s.conds)
+	if _, ok := s.model.Registers[reg.HardwareErrorStatus]; ok {
+		active |= s.get(reg.HardwareErrorStatus)
+		s.set(reg.HardwareErrorStatus, active)
+	}
+
+	if active&s.get(reg.AlarmShutdown) != 0 {
+		s.set(reg.TorqueEnable, 0)
+	}
+}
+
+// limits returns the lowest and highest positions which the servo can move to.
+func (s *Servo) limits() (float64, float64) {
+	lo := float64(s.get(reg.CwAngleLimit))
+	hi := float64(s.get(reg.CcwAngleLimit))
+	if hi <= lo {
+		hi = float64(s.model.Steps - 1)
+	}
+
+	return lo, hi
+}
+
+// wheel returns true if the servo is in wheel mode: its ControlMode says so, or
+// (if it doesn't have one) both of its angle limits are zero.
+func (s *Servo) wheel() bool {
+	if _, ok := s.model.Registers[reg.ControlMode]; ok {
+		return s.get(reg.ControlMode) == 1
+	}
+
+	return s.get(reg.CwAngleLimit) == 0 && s.get(reg.CcwAngleLimit) == 0
+}
+
+// wheelSpeed returns the velocity (in rpm) which the servo turns at in wheel
+// mode. The moving speed is a magnitude in the low ten bits, and a direction in
+// bit 10, which is set for clockwise (i.e. negative). Zero means stopped.
+func (s *Servo) wheelSpeed() float64 {
+	v := s.get(reg.MovingSpeed)
+	if _, ok := s.model.Registers[reg.GoalVelocity]; ok {
+		v = s.get(reg.GoalVelocity)
+	}
+
+	rpm := math.Min(float64(v&1023)*s.model.SpeedUnit, s.model.MaxRPM)
+	if v&1024 != 0 {
+		return -rpm
+	}
+
+	return rpm
+}
+
+// speedLimit returns the maximum speed (in rpm) which the servo will move at.
+// Zero means no limit, i.e. as fast as the motor can go.
+func (s *Servo) speedLimit() float64 {
+	v := s.get(reg.MovingSpeed)
+	if _, ok := s.model.Registers[reg.GoalVelocity]; ok {
+		v = s.get(reg.GoalVelocity)
+	}
+
+	rpm := float64(v&1023) * s.model.SpeedUnit
+	if rpm == 0 || rpm > s.model.MaxRPM {
+		return s.model.MaxRPM
+	}
+
+	return rpm
+}
+
+// torqueLimit returns the raw value (0-1023) of whichever register limits the
+// torque of this model.
+func (s *Servo) torqueLimit() int {
+	for _, n := range []reg.RegName{reg.TorqueLimit, reg.GoalTorque, reg.MaxTorque} {
+		if _, ok := s.model.Registers[n]; ok {
+			return s.get(n) & 1023
+		}
+	}
+
+	return 1023
+}
+
+// signMagnitude encodes a value in the format of the PresentSpeed and
+// PresentLoad registers: ten bits of magnitude, and the eleventh bit set if the
+// direction is negative (CW).
+func signMagnitude(v float64) int {
+	m := int(math.Min(1023, math.Round(math.Abs(v))))
+	if v < 0 && m > 0 {
+		m |= 1024
+	}
+
+	return m
+}
@@ -0,0 +1,158 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adammck/dynamixel/network"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMove(t *testing.T) {
+	c := NewVirtualClock()
+	b := NewWithClock(c)
+	b.Add(AX12, 1)
+
+	s, _ := ax.New(network.New(b), 1)
+	assert.NoError(t, s.SetTorqueEnable(true))
+	assert.NoError(t, s.SetGoalPosition(612))
+
+	c.Advance(20 * time.Millisecond)
+	p, _ := s.PresentPosition()
+	assert.True(t, p > 512 && p < 612, "should be on the way (p=%d)", p)
+	m, _ := s.Moving()
+	assert.True(t, m)
+
+	c.Advance(time.Second)
+	p, _ = s.PresentPosition()
+	assert.Equal(t, 612, p)
+	m, _ = s.Moving()
+	assert.False(t, m)
+}
+
+func TestMovingSpeed(t *testing.T) {
+	c := NewVirtualClock()
+	b := NewWithClock(c)
+	sim := b.Add(AX12, 1)
+	sim.Set(reg.TorqueEnable, 1)
+	sim.Set(reg.MovingSpeed, 100) // 11.1 rpm = ~227 positions/sec
+	sim.Set(reg.GoalPosition, 1000)
+
+	c.Advance(time.Second)
+	assert.InDelta(t, 512+227, sim.Get(reg.PresentPosition), 5)
+	assert.InDelta(t, 100, sim.Get(reg.PresentSpeed), 1)
+
+	// moving backwards sets the direction bit
+	sim.Set(reg.GoalPosition, 0)
+	c.Advance(time.Second)
+	assert.InDelta(t, 1024+100, sim.Get(reg.PresentSpeed), 1)
+}
+
+func TestTorqueDisabled(t *testing.T) {
+	c := NewVirtualClock()
+	b := NewWithClock(c)
+	sim := b.Add(XL320, 1)
+	sim.Set(reg.GoalPosition, 612)
+
+	c.Advance(time.Second)
+	assert.Equal(t, 512, sim.Get(reg.PresentPosition))
+}
+
+func TestLoad(t *testing.T) {
+	c := NewVirtualClock()
+	b := NewWithClock(c)
+	sim := b.Add(AX12, 1)
+	sim.Set(reg.TorqueEnable, 1)
+	sim.Set(reg.GoalPosition, 1000)
+
+	// half of the stall torque, pushing CCW
+	sim.SetLoad(func(angle, rpm float64) float64 {
+		return 0.75
+	})
+
+	c.Advance(100 * time.Millisecond)
+	assert.InDelta(t, 1024+512, sim.Get(reg.PresentLoad), 1)
+	assert.InDelta(t, 59.0/2/0.111, sim.Get(reg.PresentSpeed), 1)
+}
+
+func TestOverload(t *testing.T) {
+	c := NewVirtualClock()
+	b := NewWithClock(c)
+	sim := b.Add(XL320, 1)
+	sim.Set(reg.TorqueEnable, 1)
+	sim.SetLoad(func(angle, rpm float64) float64 {
+		return -1
+	})
+
+	c.Advance(time.Millisecond)
+	assert.Equal(t, 0, sim.Get(reg.TorqueEnable))
+	assert.Equal(t, 0x01, sim.Get(reg.HardwareErrorStatus))
+
+	// the error is latched until reboot
+	sim.SetLoad(nil)
+	c.Advance(time.Millisecond)
+	assert.Equal(t, 0x01, sim.Get(reg.HardwareErrorStatus))
+}
+
+func TestOverheating(t *testing.T) {
+	c := NewVirtualClock()
+	b := NewWithClock(c)
+	sim := b.Add(AX12, 1)
+	sim.Set(reg.TorqueEnable, 1)
+	sim.SetLoad(func(angle, rpm float64) float64 {
+		return 1.45
+	})
+
+	c.Advance(time.Minute)
+	assert.True(t, sim.Get(reg.PresentTemperature) > 40)
+	assert.Equal(t, 1, sim.Get(reg.TorqueEnable))
+
+	// the torque is disabled when it reaches 70C, and then it cools down
+	c.Advance(3 * time.Minute)
+	assert.Equal(t, 0, sim.Get(reg.TorqueEnable))
+	assert.True(t, sim.Get(reg.PresentTemperature) < 70)
+}
+
+func TestWheel(t *testing.T) {
+	c := NewVirtualClock()
+	b := NewWithClock(c)
+	sim := b.Add(AX12, 1)
+
+	s, _ := ax.New(network.New(b), 1)
+	assert.NoError(t, s.SetMode(servo.Wheel))
+	assert.Equal(t, 0, sim.Get(reg.CcwAngleLimit))
+	assert.NoError(t, s.SetTorqueEnable(true))
+	assert.NoError(t, s.SetWheelVelocity(-30))
+
+	tr, err := servo.NewTracker(s)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tr.Now = c.Now
+
+	// 30 rpm clockwise is a turn every two seconds, through the dead band. (The
+	// bus takes a little time too, so measure it.)
+	start := c.Now()
+	for i := 0; i < 200; i++ {
+		c.Advance(20 * time.Millisecond)
+		assert.NoError(t, tr.Update())
+	}
+
+	v, err := s.PresentVelocity()
+	assert.NoError(t, err)
+	assert.InDelta(t, -30, v, 0.2)
+	assert.InDelta(t, -c.Now().Sub(start).Seconds()/2, tr.Revolutions(), 0.02)
+	assert.True(t, tr.Revolutions() < -2)
+
+	// Back to joint mode, where it moves to the goal again.
+	assert.NoError(t, s.SetMode(servo.Joint))
+	assert.Equal(t, 1023, sim.Get(reg.CcwAngleLimit))
+	assert.NoError(t, s.SetGoalPosition(512))
+	c.Advance(2 * time.Second)
+	p, _ := s.PresentPosition()
+	assert.Equal(t, 512, p)
+}
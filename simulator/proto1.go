@@ -0,0 +1,111 @@
+package simulator
+
+import (
+	v1 "github.com/adammck/dynamixel/protocol/v1"
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+// Error bits of a protocol 1 status packet. The error conditions (overheating,
+// etc) are also included in every status packet, via Servo.conds.
+// See: http://support.robotis.com/en/product/dynamixel/communication/dxl_packet.htm#Status_Packet
+var faults1 = map[fault]reg.AlarmFlags{
+	faultNone:        0,
+	faultRange:       reg.Range,
+	faultAccess:      reg.Range,
+	faultChecksum:    reg.Checksum,
+	faultLength:      reg.Instruction,
+	faultInstruction: reg.Instruction,
+}
+
+// exec1 executes a protocol 1 instruction packet on every servo it's addressed
+// to, and queues the resulting status packets.
+func (b *Bus) exec1(pkt *v1.Packet, badChecksum bool) {
+	for _, s := range b.targets(1, pkt.ID) {
+		if badChecksum {
+			if shouldRespond(s, pkt.ID) {
+				b.status1(s, pkt.ID, faultChecksum, nil)
+			}
+			continue
+		}
+
+		b.exec1One(s, pkt)
+	}
+}
+
+func (b *Bus) exec1One(s *Servo, pkt *v1.Packet) {
+	ps := pkt.Params
+
+	switch pkt.Instruction {
+	case v1.Ping:
+		if pkt.ID != broadcastID {
+			b.status1(s, pkt.ID, faultNone, nil)
+		}
+
+	case v1.ReadData:
+		if len(ps) != 2 {
+			b.reply1(s, pkt.ID, faultLength)
+			return
+		}
+
+		data, f := s.read(int(ps[0]), int(ps[1]))
+		if pkt.ID != broadcastID && s.get(reg.StatusReturnLevel) >= 1 {
+			b.status1(s, pkt.ID, f, data)
+		}
+
+	case v1.WriteData, v1.RegWrite:
+		if len(ps) < 2 {
+			b.reply1(s, pkt.ID, faultLength)
+			return
+		}
+
+		var f fault
+		if pkt.Instruction == v1.WriteData {
+			f = s.write(int(ps[0]), ps[1:])
+		} else {
+			f = s.regWrite(int(ps[0]), ps[1:])
+		}
+
+		b.reply1(s, pkt.ID, f)
+
+	case v1.Action:
+		s.action()
+		b.reply1(s, pkt.ID, faultNone)
+
+	case v1.Reset:
+		b.reply1(s, pkt.ID, faultNone)
+		s.factoryReset(false, false)
+
+	case v1.SyncWrite:
+		if len(ps) < 2 {
+			return
+		}
+
+		addr, n := int(ps[0]), int(ps[1])
+		for i := 2; i+n+1 <= len(ps); i += n + 1 {
+			if int(ps[i]) == s.get(reg.ServoID) {
+				s.write(addr, ps[i+1:i+n+1])
+			}
+		}
+
+	default:
+		b.reply1(s, pkt.ID, faultInstruction)
+	}
+}
+
+// reply1 queues a status packet with no params, if the servo's return level
+// says that it should send one.
+func (b *Bus) reply1(s *Servo, ID int, f fault) {
+	if shouldRespond(s, ID) {
+		b.status1(s, ID, f, nil)
+	}
+}
+
+func (b *Bus) status1(s *Servo, ID int, f fault, params []byte) {
+	pkt := &v1.Packet{
+		ID:          ID,
+		Instruction: byte(faults1[f] | s.conds),
+		Params:      params,
+	}
+
+	b.respond(s, pkt.Bytes())
+}
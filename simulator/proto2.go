@@ -0,0 +1,184 @@
+package simulator
+
+import (
+	v2 "github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/utils"
+)
+
+// Error codes of a protocol 2 status packet.
+// See: http://support.robotis.com/en/product/dynamixel_pro/communication/instruction_status_packet.htm
+var faults2 = map[fault]byte{
+	faultNone:        0x00,
+	faultInstruction: 0x02,
+	faultChecksum:    0x03,
+	faultRange:       0x04,
+	faultLength:      0x05,
+	faultAccess:      0x07,
+}
+
+// exec2 executes a protocol 2 instruction packet on every servo it's addressed
+// to, and queues the resulting status packets.
+func (b *Bus) exec2(pkt *v2.Packet, badChecksum bool) {
+	ps := pkt.Params
+
+	// The multi-servo reads are addressed to the broadcast ID, but each servo
+	// responds separately, in the order in which they are listed.
+	switch pkt.Instruction {
+	case v2.SyncRead:
+		if len(ps) < 4 {
+			return
+		}
+
+		addr, n := word(ps[0:]), word(ps[2:])
+		for _, id := range ps[4:] {
+			b.read2(int(id), addr, n)
+		}
+		return
+
+	case v2.BulkRead:
+		for i := 0; i+5 <= len(ps); i += 5 {
+			b.read2(int(ps[i]), word(ps[i+1:]), word(ps[i+3:]))
+		}
+		return
+	}
+
+	for _, s := range b.targets(2, pkt.ID) {
+		if badChecksum {
+			if shouldRespond(s, pkt.ID) {
+				b.status2(s, pkt.ID, faultChecksum, nil)
+			}
+			continue
+		}
+
+		b.exec2One(s, pkt)
+	}
+}
+
+func (b *Bus) exec2One(s *Servo, pkt *v2.Packet) {
+	ps := pkt.Params
+
+	switch pkt.Instruction {
+	case v2.Ping:
+		id := pkt.ID
+		if id == broadcastID {
+			id = s.get(reg.ServoID)
+		}
+
+		m := s.get(reg.ModelNumber)
+		b.status2(s, id, faultNone, []byte{utils.Low(m), utils.High(m), byte(s.get(reg.FirmwareVersion))})
+
+	case v2.ReadData:
+		if len(ps) != 4 {
+			b.reply2(s, pkt.ID, faultLength)
+			return
+		}
+
+		data, f := s.read(word(ps[0:]), word(ps[2:]))
+		if pkt.ID != broadcastID && s.get(reg.StatusReturnLevel) >= 1 {
+			b.status2(s, pkt.ID, f, data)
+		}
+
+	case v2.WriteData, v2.RegWrite:
+		if len(ps) < 3 {
+			b.reply2(s, pkt.ID, faultLength)
+			return
+		}
+
+		var f fault
+		if pkt.Instruction == v2.WriteData {
+			f = s.write(word(ps[0:]), ps[2:])
+		} else {
+			f = s.regWrite(word(ps[0:]), ps[2:])
+		}
+
+		b.reply2(s, pkt.ID, f)
+
+	case v2.Action:
+		s.action()
+		b.reply2(s, pkt.ID, faultNone)
+
+	case v2.FactoryReset:
+		mode := byte(0xFF)
+		if len(ps) > 0 {
+			mode = ps[0]
+		}
+
+		b.reply2(s, pkt.ID, faultNone)
+		s.factoryReset(mode == 0x01 || mode == 0x02, mode == 0x02)
+
+	case v2.Reboot:
+		b.reply2(s, pkt.ID, faultNone)
+		s.reboot()
+
+	case v2.SyncWrite:
+		if len(ps) < 4 {
+			return
+		}
+
+		addr, n := word(ps[0:]), word(ps[2:])
+		for i := 4; i+n+1 <= len(ps); i += n + 1 {
+			if int(ps[i]) == s.get(reg.ServoID) {
+				s.write(addr, ps[i+1:i+n+1])
+			}
+		}
+
+	case v2.BulkWrite:
+		for i := 0; i+5 <= len(ps); {
+			n := word(ps[i+3:])
+			if i+5+n > len(ps) {
+				break
+			}
+
+			if int(ps[i]) == s.get(reg.ServoID) {
+				s.write(word(ps[i+1:]), ps[i+5:i+5+n])
+			}
+
+			i += 5 + n
+		}
+
+	default:
+		b.reply2(s, pkt.ID, faultInstruction)
+	}
+}
+
+// read2 queues the response of the given servo to one part of a SYNC READ or
+// BULK READ instruction.
+func (b *Bus) read2(ID, addr, n int) {
+	for _, s := range b.targets(2, ID) {
+		if s.get(reg.StatusReturnLevel) >= 1 {
+			data, f := s.read(addr, n)
+			b.status2(s, ID, f, data)
+		}
+	}
+}
+
+// reply2 queues a status packet with no params, if the servo's return level
+// says that it should send one.
+func (b *Bus) reply2(s *Servo, ID int, f fault) {
+	if shouldRespond(s, ID) {
+		b.status2(s, ID, f, nil)
+	}
+}
+
+func (b *Bus) status2(s *Servo, ID int, f fault, params []byte) {
+	e := faults2[f]
+
+	// The alert bit is set while there's a hardware error.
+	if s.get(reg.HardwareErrorStatus) != 0 {
+		e |= 0x80
+	}
+
+	pkt := &v2.Packet{
+		ID:          ID,
+		Instruction: v2.Status,
+		Params:      append([]byte{e}, params...),
+	}
+
+	b.respond(s, pkt.Bytes())
+}
+
+// word decodes the little-endian uint16 at the start of b.
+func word(b []byte) int {
+	return int(b[0]) | int(b[1])<<8
+}
@@ -0,0 +1,138 @@
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// PTY serves a simulated bus on a Linux pseudo-terminal, so that any program
+// which can open a serial port by path can talk to the simulated servos.
+type PTY struct {
+	bus    *Bus
+	master *os.File
+
+	// The slave end is held open, so that reads from the master don't fail
+	// while no client has it open.
+	slave *os.File
+
+	// The path of the slave end (e.g. /dev/pts/3), to pass to clients.
+	Path string
+}
+
+// ListenPTY creates a new pseudo-terminal pair for the given bus. Call Serve to
+// start relaying bytes between them.
+func ListenPTY(b *Bus) (*PTY, error) {
+	m, err := syscall.Open("/dev/ptmx", syscall.O_RDWR|syscall.O_NOCTTY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, fmt.Errorf("opening /dev/ptmx: %s", err)
+	}
+
+	var n uint32
+	err = ioctl(m, syscall.TIOCGPTN, uintptr(unsafe.Pointer(&n)))
+	if err != nil {
+		syscall.Close(m)
+		return nil, fmt.Errorf("getting pty number: %s", err)
+	}
+
+	var unlock int32
+	err = ioctl(m, syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock)))
+	if err != nil {
+		syscall.Close(m)
+		return nil, fmt.Errorf("unlocking pty: %s", err)
+	}
+
+	path := fmt.Sprintf("/dev/pts/%d", n)
+	s, err := syscall.Open(path, syscall.O_RDWR|syscall.O_NOCTTY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		syscall.Close(m)
+		return nil, fmt.Errorf("opening %s: %s", path, err)
+	}
+
+	err = makeRaw(s)
+	if err != nil {
+		syscall.Close(s)
+		syscall.Close(m)
+		return nil, fmt.Errorf("setting raw mode: %s", err)
+	}
+
+	// The files are non-blocking, so closing them interrupts Serve.
+	return &PTY{
+		bus:    b,
+		master: os.NewFile(uintptr(m), "/dev/ptmx"),
+		slave:  os.NewFile(uintptr(s), path),
+		Path:   path,
+	}, nil
+}
+
+// Serve relays instruction packets from the pseudo-terminal to the bus, and
+// status packets back, until the PTY is closed.
+func (p *PTY) Serve() error {
+	buf := make([]byte, 256)
+
+	for {
+		n, err := p.master.Read(buf)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.bus.Write(buf[:n])
+		if err != nil {
+			return err
+		}
+
+		// Relay any responses. The bus blocks until each is ready, and returns
+		// EOF when there are no more.
+		for {
+			n, err = p.bus.Read(buf)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			_, err = p.master.Write(buf[:n])
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Close closes both ends of the pseudo-terminal.
+func (p *PTY) Close() error {
+	p.slave.Close()
+	return p.master.Close()
+}
+
+// makeRaw disables all of the terminal's line editing and translation, so bytes
+// are passed through unmodified. This is the equivalent of cfmakeraw(3).
+func makeRaw(fd int) error {
+	var t syscall.Termios
+	err := ioctl(fd, syscall.TCGETS, uintptr(unsafe.Pointer(&t)))
+	if err != nil {
+		return err
+	}
+
+	t.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	t.Oflag &^= syscall.OPOST
+	t.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	t.Cflag &^= syscall.CSIZE | syscall.PARENB
+	t.Cflag |= syscall.CS8
+	t.Cc[syscall.VMIN] = 1
+	t.Cc[syscall.VTIME] = 0
+
+	return ioctl(fd, syscall.TCSETS, uintptr(unsafe.Pointer(&t)))
+}
+
+func ioctl(fd int, req uint, arg uintptr) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), uintptr(req), arg)
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
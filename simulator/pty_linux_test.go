@@ -0,0 +1,38 @@
+package simulator
+
+import (
+	"os"
+	"testing"
+
+	"github.com/adammck/dynamixel/network"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPTY(t *testing.T) {
+	b := New()
+	sim := b.Add(AX12, 1)
+
+	p, err := ListenPTY(b)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- p.Serve()
+	}()
+
+	f, err := os.OpenFile(p.Path, os.O_RDWR, 0)
+	if assert.NoError(t, err) {
+		s, _ := ax.New(network.New(f), 1)
+		assert.NoError(t, s.Ping())
+		assert.NoError(t, s.SetGoalPosition(0xFF))
+		assert.Equal(t, 0xFF, sim.Get(reg.GoalPosition))
+		f.Close()
+	}
+
+	p.Close()
+	assert.Error(t, <-done)
+}
@@ -0,0 +1,255 @@
+package simulator
+
+import (
+	"math"
+
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/utils"
+)
+
+// fault is the reason that a simulated servo rejected an instruction. The two
+// protocols report these differently, so they're translated into error bits
+// (v1) or an error code (v2) when the status packet is built.
+type fault int
+
+const (
+	faultNone fault = iota
+	faultChecksum
+	faultInstruction
+	faultRange
+	faultAccess
+	faultLength
+)
+
+// Servo is a single simulated servo, which owns a control table laid out
+// according to its model.
+type Servo struct {
+	bus   *Bus
+	model *Model
+	table []byte
+
+	// The write buffered by the last REG_WRITE instruction, waiting for ACTION.
+	// Nil if there isn't one.
+	registered *write
+
+	// The physical state, which is more precise than the Present* registers.
+	// The velocity is in positions per second.
+	pos  float64
+	vel  float64
+	temp float64
+
+	// The torque which the motor is exerting, as a fraction of what's available,
+	// and the error conditions (overload, etc) as of the last step.
+	effort float64
+	conds  reg.AlarmFlags
+
+	// Optional function returning the external torque on the horn.
+	load Load
+}
+
+type write struct {
+	addr int
+	data []byte
+}
+
+func newServo(b *Bus, m *Model, ID int) *Servo {
+	s := &Servo{
+		bus:   b,
+		model: m,
+		table: make([]byte, m.size()),
+		pos:   float64(m.Defaults[reg.PresentPosition]),
+		temp:  float64(m.Defaults[reg.PresentTemperature]),
+	}
+
+	s.reset(0, len(s.table))
+	s.set(reg.ServoID, ID)
+	return s
+}
+
+// Model returns the model which this servo is simulating.
+func (s *Servo) Model() *Model {
+	return s.model
+}
+
+// ID returns the current ID of the servo, which can be changed by writing to
+// the ServoID register like any other.
+func (s *Servo) ID() int {
+	return s.Get(reg.ServoID)
+}
+
+// Get returns the value of a register from the control table, or zero if the
+// model doesn't have that register.
+func (s *Servo) Get(n reg.RegName) int {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.update()
+	return s.get(n)
+}
+
+// Set writes a value to a register in the control table, regardless of whether
+// it's read-only or in range. This is intended for setting up tests, e.g. by
+// moving the servo to a specific PresentPosition.
+func (s *Servo) Set(n reg.RegName, v int) {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.update()
+	s.set(n, v)
+
+	switch n {
+	case reg.PresentPosition:
+		s.pos = float64(v)
+		s.vel = 0
+
+	case reg.PresentTemperature:
+		s.temp = float64(v)
+	}
+}
+
+// SetLoad sets a function which returns the external torque acting on the
+// servo. Pass nil to remove it.
+func (s *Servo) SetLoad(f Load) {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.update()
+	s.load = f
+}
+
+func (s *Servo) get(n reg.RegName) int {
+	r, ok := s.model.Registers[n]
+	if !ok {
+		return 0
+	}
+
+	v, _ := r.Decode(s.table[r.Address : r.Address+r.Length])
+	return v
+}
+
+func (s *Servo) set(n reg.RegName, v int) {
+	r, ok := s.model.Registers[n]
+	if !ok {
+		return
+	}
+
+	copy(s.table[r.Address:], utils.IntToBytes(v, r.Length))
+}
+
+// reset restores every register between the given addresses to its default.
+// Resetting doesn't move the horn, so the goal position is set to the present
+// position rather than the default.
+func (s *Servo) reset(from, to int) {
+	for n, r := range s.model.Registers {
+		if r.Address >= from && r.Address < to {
+			s.set(n, s.model.Defaults[n])
+		}
+	}
+
+	if r, ok := s.model.Registers[reg.GoalPosition]; ok && r.Address >= from {
+		s.set(reg.GoalPosition, int(math.Round(s.pos)))
+	}
+
+	s.vel = 0
+	s.sync()
+}
+
+// read returns n bytes of the control table starting at addr.
+func (s *Servo) read(addr, n int) ([]byte, fault) {
+	if addr < 0 || n < 0 || addr+n > len(s.table) {
+		return nil, faultAccess
+	}
+
+	return append([]byte{}, s.table[addr:addr+n]...), faultNone
+}
+
+// check returns the fault which writing the given data at addr would cause, or
+// faultNone if it's okay. Every register which the data completely covers must
+// be writable, and the new value must be within its range.
+func (s *Servo) check(addr int, data []byte) fault {
+	if addr < 0 || addr+len(data) > len(s.table) {
+		return faultAccess
+	}
+
+	for _, r := range s.model.Registers {
+		start := r.Address - addr
+		end := start + r.Length
+		if end <= 0 || start >= len(data) {
+			continue
+		}
+
+		if r.Access == reg.RO {
+			return faultAccess
+		}
+
+		if r.Area == reg.EEPROM && s.model.LockEEPROM && s.get(reg.TorqueEnable) == 1 {
+			return faultAccess
+		}
+
+		if start < 0 || end > len(data) {
+			continue
+		}
+
+		v, _ := r.Decode(data[start:end])
+		if v < r.Min || v > r.Max {
+			return faultRange
+		}
+	}
+
+	return faultNone
+}
+
+// write writes the given data to the control table, if it's allowed.
+func (s *Servo) write(addr int, data []byte) fault {
+	if f := s.check(addr, data); f != faultNone {
+		return f
+	}
+
+	copy(s.table[addr:], data)
+	return faultNone
+}
+
+// regWrite buffers the given data until the ACTION instruction is received.
+func (s *Servo) regWrite(addr int, data []byte) fault {
+	if f := s.check(addr, data); f != faultNone {
+		return f
+	}
+
+	s.registered = &write{addr, append([]byte{}, data...)}
+	s.set(reg.RegisteredInstruction, 1)
+	return faultNone
+}
+
+// action applies the write buffered by regWrite, if there is one.
+func (s *Servo) action() {
+	if s.registered == nil {
+		return
+	}
+
+	copy(s.table[s.registered.addr:], s.registered.data)
+	s.registered = nil
+	s.set(reg.RegisteredInstruction, 0)
+}
+
+// factoryReset restores the control table to the factory defaults, optionally
+// keeping the current ID and baud rate.
+func (s *Servo) factoryReset(keepID, keepBaud bool) {
+	id := s.get(reg.ServoID)
+	baud := s.get(reg.BaudRate)
+
+	s.reset(0, len(s.table))
+	s.registered = nil
+
+	if keepID {
+		s.set(reg.ServoID, id)
+	}
+	if keepBaud {
+		s.set(reg.BaudRate, baud)
+	}
+}
+
+// reboot resets the RAM area of the control table, as if it was power-cycled.
+func (s *Servo) reboot() {
+	s.reset(s.model.RAM, len(s.table))
+	s.registered = nil
+}
@@ -0,0 +1,263 @@
+// Package simulator provides an in-process Dynamixel bus, populated by
+// simulated servos. It implements io.ReadWriteCloser, so it can be used in
+// place of a serial port anywhere in this library (e.g. network.New), which
+// makes it possible to test the whole stack without any hardware.
+package simulator
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	v1 "github.com/adammck/dynamixel/protocol/v1"
+	v2 "github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+)
+
+const (
+
+	// Instructions sent to this ID are executed by every servo.
+	broadcastID = 0xFE
+
+	// The unit of the ReturnDelayTime register.
+	returnDelayUnit = 2 * time.Microsecond
+
+	// The longest interval over which the servos are simulated in one step.
+	tick = time.Millisecond
+)
+
+// Bus is a simulated half-duplex bus. Instruction packets written to it are
+// decoded and executed by any servos with a matching ID, and their status
+// packets (if any) become available to read after their return delay time.
+type Bus struct {
+	mu     sync.Mutex
+	servos []*Servo
+	closed bool
+
+	clock Clock
+
+	// The time up to which the servos have been simulated.
+	last time.Time
+
+	// Bytes which have been written, but don't yet form a complete packet.
+	in []byte
+
+	// Status packets waiting to be read.
+	out []*response
+}
+
+type response struct {
+	at  time.Time
+	buf []byte
+}
+
+// New returns an empty bus, which runs in real time. Use Add to connect servos
+// to it.
+func New() *Bus {
+	return NewWithClock(realClock{})
+}
+
+// NewWithClock returns an empty bus, which runs according to the given clock.
+// This is useful in tests, with a VirtualClock.
+func NewWithClock(c Clock) *Bus {
+	return &Bus{
+		clock: c,
+		last:  c.Now(),
+	}
+}
+
+// Add connects a new servo of the given model to the bus, with its control
+// table set to the factory defaults (except for the ID).
+func (b *Bus) Add(m *Model, ID int) *Servo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.update()
+	s := newServo(b, m, ID)
+	b.servos = append(b.servos, s)
+	return s
+}
+
+// Servo returns the servo with the given ID, or nil if there isn't one.
+func (b *Bus) Servo(ID int) *Servo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.update()
+
+	for _, s := range b.servos {
+		if s.get(reg.ServoID) == ID {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// Read reads any status packets which have been sent. If one is on its way, it
+// blocks until the servo's return delay time has passed. If none are, it
+// returns zero bytes and io.EOF, like a serial port with nothing to read.
+func (b *Bus) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return 0, io.ErrClosedPipe
+	}
+
+	if len(b.out) > 0 {
+		if d := b.out[0].at.Sub(b.clock.Now()); d > 0 {
+			b.mu.Unlock()
+			b.clock.Sleep(d)
+			b.mu.Lock()
+
+			if b.closed {
+				return 0, io.ErrClosedPipe
+			}
+		}
+	}
+
+	b.update()
+
+	n := 0
+	now := b.clock.Now()
+	for len(b.out) > 0 && n < len(p) && !b.out[0].at.After(now) {
+		m := copy(p[n:], b.out[0].buf)
+		b.out[0].buf = b.out[0].buf[m:]
+		n += m
+
+		if len(b.out[0].buf) == 0 {
+			b.out = b.out[1:]
+		}
+	}
+
+	if n == 0 {
+		return 0, io.EOF
+	}
+
+	return n, nil
+}
+
+// Write sends bytes to every servo on the bus. Complete instruction packets are
+// executed immediately, and partial packets are buffered until the rest of the
+// bytes are written.
+func (b *Bus) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return 0, io.ErrClosedPipe
+	}
+
+	b.update()
+	b.in = append(b.in, p...)
+	for b.next() {
+	}
+
+	return len(p), nil
+}
+
+// Close disconnects the bus. Subsequent reads and writes will fail.
+func (b *Bus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return errors.New("bus already closed")
+	}
+
+	b.closed = true
+	return nil
+}
+
+// update simulates the servos up to the current time.
+func (b *Bus) update() {
+	now := b.clock.Now()
+	for b.last.Before(now) {
+		dt := now.Sub(b.last)
+		if dt > tick {
+			dt = tick
+		}
+
+		for _, s := range b.servos {
+			s.step(dt.Seconds())
+		}
+
+		b.last = b.last.Add(dt)
+	}
+}
+
+// next tries to decode and execute one packet from the input buffer. Returns
+// true if there might be more.
+func (b *Bus) next() bool {
+
+	// Discard any junk before the header. Both protocols start with 0xFF 0xFF,
+	// so it's the next byte which tells us which one this packet is.
+	for len(b.in) >= 2 && (b.in[0] != 0xFF || b.in[1] != 0xFF) {
+		b.in = b.in[1:]
+	}
+
+	if len(b.in) < 4 {
+		return false
+	}
+
+	if b.in[2] == 0xFD && b.in[3] == 0x00 {
+		pkt, n, err := v2.ParsePacket(b.in)
+		if err == v2.ErrIncomplete {
+			return false
+		}
+		if pkt == nil {
+			b.in = b.in[1:]
+			return true
+		}
+
+		b.in = b.in[n:]
+		b.exec2(pkt, err == v2.ErrChecksum)
+		return true
+	}
+
+	pkt, n, err := v1.ParsePacket(b.in)
+	if err == v1.ErrIncomplete {
+		return false
+	}
+	if pkt == nil {
+		b.in = b.in[1:]
+		return true
+	}
+
+	b.in = b.in[n:]
+	b.exec1(pkt, err == v1.ErrChecksum)
+	return true
+}
+
+// targets returns the servos which speak the given protocol and should execute
+// an instruction sent to the given ID.
+func (b *Bus) targets(proto, ID int) []*Servo {
+	out := []*Servo{}
+	for _, s := range b.servos {
+		if s.model.Protocol == proto && (ID == broadcastID || s.get(reg.ServoID) == ID) {
+			out = append(out, s)
+		}
+	}
+
+	return out
+}
+
+// respond queues a status packet from the given servo, to become readable after
+// its return delay time (or after the previous response, if that's later).
+func (b *Bus) respond(s *Servo, buf []byte) {
+	at := b.clock.Now()
+	if len(b.out) > 0 && b.out[len(b.out)-1].at.After(at) {
+		at = b.out[len(b.out)-1].at
+	}
+
+	delay := time.Duration(s.get(reg.ReturnDelayTime)) * returnDelayUnit
+	b.out = append(b.out, &response{at.Add(delay), buf})
+}
+
+// shouldRespond returns whether the given servo should send a status packet in
+// response to an instruction which isn't PING or READ.
+func shouldRespond(s *Servo, ID int) bool {
+	return ID != broadcastID && s.get(reg.StatusReturnLevel) == 2
+}
@@ -0,0 +1,233 @@
+package simulator
+
+import (
+	"testing"
+
+	"github.com/adammck/dynamixel/network"
+	v1 "github.com/adammck/dynamixel/protocol/v1"
+	v2 "github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/adammck/dynamixel/servo/xl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAX(t *testing.T) {
+	b := New()
+	sim := b.Add(AX12, 1)
+	b.Add(AX12, 2)
+
+	s, _ := ax.New(network.New(b), 1)
+	assert.NoError(t, s.Ping())
+
+	v, err := s.ModelNumber()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 12, v)
+	}
+
+	// write, then read back
+	assert.NoError(t, s.SetGoalPosition(100))
+	assert.Equal(t, 100, sim.Get(reg.GoalPosition))
+	v, err = s.GoalPosition()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 100, v)
+	}
+
+	// the other servo wasn't touched
+	assert.Equal(t, 512, b.Servo(2).Get(reg.GoalPosition))
+
+	// read-only registers are rejected by the servo, not just the library
+	p := v1.New(network.New(b))
+	err = p.WriteData(1, 0x24, []byte{1, 0}, true)
+	assert.EqualError(t, err, "status error: range")
+
+	// no such servo
+	s, _ = ax.New(network.New(b), 3)
+	assert.Error(t, s.Ping())
+}
+
+func TestXL(t *testing.T) {
+	b := New()
+	sim := b.Add(XL320, 1)
+
+	s, _ := xl.New(network.New(b), 1)
+	assert.NoError(t, s.Ping())
+
+	v, err := s.ModelNumber()
+	if assert.NoError(t, err) {
+		assert.Equal(t, 350, v)
+	}
+
+	assert.NoError(t, s.SetLED(true))
+	assert.Equal(t, 1, sim.Get(reg.Led))
+
+	// out of range values are rejected by the servo
+	p := v2.New(network.New(b))
+	err = p.WriteData(1, 0x0b, []byte{9}, true)
+	assert.EqualError(t, err, "data range error")
+	assert.Equal(t, 2, sim.Get(reg.ControlMode))
+
+	// protocol 1 servos ignore protocol 2 instructions
+	b.Add(AX12, 2)
+	_, err = p.ReadData(2, 0x00, 2)
+	assert.Error(t, err)
+}
+
+func TestReturnLevel(t *testing.T) {
+	b := New()
+	sim := b.Add(AX12, 1)
+	sim.Set(reg.StatusReturnLevel, 1)
+
+	// reads get a response, but writes don't
+	s, _ := ax.New(network.New(b), 1)
+	assert.NoError(t, s.SetLED(true))
+	assert.Equal(t, 1, sim.Get(reg.Led))
+
+	n := network.New(b)
+	p := v1.New(n)
+	assert.NoError(t, p.WriteData(1, 0x19, []byte{0}, false))
+	_, err := n.Read(make([]byte, 1))
+	assert.Error(t, err, "no status packet should have been sent")
+}
+
+func TestChangeID(t *testing.T) {
+	b := New()
+	b.Add(XL320, 1)
+
+	s, _ := xl.New(network.New(b), 1)
+	assert.NoError(t, s.SetServoID(5))
+	assert.Nil(t, b.Servo(1))
+
+	s, _ = xl.New(network.New(b), 5)
+	assert.NoError(t, s.Ping())
+}
+
+func TestTorqueSafe(t *testing.T) {
+	b := New()
+	sim := b.Add(XL320, 1)
+	sim.Set(reg.TorqueEnable, 1)
+
+	s, _ := xl.New(network.New(b), 1)
+	assert.Error(t, s.SetReturnDelayTime(100), "EEPROM should be locked while torque is enabled")
+	assert.Equal(t, 0, s.EEPROMWrites())
+
+	s.SetTorqueSafe(true)
+	assert.NoError(t, s.SetReturnDelayTime(100))
+	assert.Equal(t, 100, sim.Get(reg.ReturnDelayTime))
+	assert.Equal(t, 1, sim.Get(reg.TorqueEnable), "torque should have been re-enabled")
+	assert.Equal(t, 1, s.EEPROMWrites())
+
+	assert.NoError(t, s.SetServoID(7))
+	assert.Equal(t, 7, sim.ID())
+	assert.Equal(t, 1, sim.Get(reg.TorqueEnable))
+}
+
+func TestRegWrite(t *testing.T) {
+	b := New()
+	one := b.Add(AX12, 1)
+	two := b.Add(AX12, 2)
+
+	n := network.New(b)
+	for _, sim := range []*Servo{one, two} {
+		s, _ := ax.New(n, sim.ID())
+		s.SetBuffered(true)
+		assert.NoError(t, s.SetGoalPosition(200))
+		assert.Equal(t, 512, sim.Get(reg.GoalPosition))
+		assert.Equal(t, 1, sim.Get(reg.RegisteredInstruction))
+	}
+
+	assert.NoError(t, v1.New(n).Action())
+	for _, sim := range []*Servo{one, two} {
+		assert.Equal(t, 200, sim.Get(reg.GoalPosition))
+		assert.Equal(t, 0, sim.Get(reg.RegisteredInstruction))
+	}
+}
+
+func TestSyncRead(t *testing.T) {
+	b := New()
+	b.Add(XL320, 1).Set(reg.PresentPosition, 0x123)
+	b.Add(XL320, 2).Set(reg.PresentPosition, 0x356)
+
+	pkt := &v2.Packet{
+		ID:          broadcastID,
+		Instruction: v2.SyncRead,
+		Params:      []byte{0x25, 0x00, 0x02, 0x00, 2, 1},
+	}
+	b.Write(pkt.Bytes())
+
+	// the network retries until the return delay has passed
+	n := network.New(b)
+	for _, exp := range []struct {
+		id   int
+		data []byte
+	}{
+		{2, []byte{0x00, 0x56, 0x03}},
+		{1, []byte{0x00, 0x23, 0x01}},
+	} {
+		buf := make([]byte, 13)
+		_, err := n.Read(buf)
+		if assert.NoError(t, err) {
+			pkt, _, err := v2.ParsePacket(buf)
+			if assert.NoError(t, err) {
+				assert.Equal(t, exp.id, pkt.ID)
+				assert.Equal(t, exp.data, pkt.Params)
+			}
+		}
+	}
+}
+
+func TestGroup(t *testing.T) {
+	b := New()
+	b.Add(AX12, 1)
+	b.Add(AX12, 2)
+	b.Add(XL320, 3).Set(reg.PresentPosition, 300)
+	b.Add(XL320, 4).Set(reg.PresentPosition, 400)
+
+	n := network.New(b)
+	var servos []*servo.Servo
+	for id := 1; id <= 4; id++ {
+		var s *servo.Servo
+		if id <= 2 {
+			s, _ = ax.New(n, id)
+		} else {
+			s, _ = xl.New(n, id)
+		}
+
+		servos = append(servos, s)
+	}
+
+	g := servo.NewGroup(servos)
+	assert.NoError(t, g.SetGoalPositions([]int{100, 200, 300, 400}))
+	for id, exp := range map[int]int{1: 100, 2: 200, 3: 300, 4: 400} {
+		assert.Equal(t, exp, b.Servo(id).Get(reg.GoalPosition))
+	}
+
+	assert.NoError(t, g.SetLED(true))
+	for id := 1; id <= 4; id++ {
+		assert.Equal(t, 1, b.Servo(id).Get(reg.Led))
+	}
+
+	pos, err := g.ReadPresentPositions()
+	assert.NoError(t, err)
+	assert.Equal(t, 300, pos[3])
+	assert.Equal(t, 400, pos[4])
+	assert.Len(t, pos, 4)
+}
+
+func TestBadChecksum(t *testing.T) {
+	b := New()
+	b.Add(AX12, 1)
+
+	n := network.New(b)
+	n.Write([]byte{0xFF, 0xFF, 0x01, 0x02, 0x01, 0x00})
+
+	buf := make([]byte, 6)
+	_, err := n.Read(buf)
+	if assert.NoError(t, err) {
+		pkt, _, err := v1.ParsePacket(buf)
+		if assert.NoError(t, err) {
+			assert.Equal(t, byte(0x10), pkt.Instruction)
+		}
+	}
+}
@@ -0,0 +1,520 @@
+// Package sniffer decodes the traffic on a Dynamixel bus, as seen by a passive
+// observer: it frames protocol 1 and 2 packets out of a raw byte stream, pairs
+// instructions with the status packets sent in response, and describes them in
+// terms of the registers of the servos involved.
+package sniffer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/adammck/dynamixel/protocol/v1"
+	v2 "github.com/adammck/dynamixel/protocol/v2"
+	reg "github.com/adammck/dynamixel/registers"
+	"github.com/adammck/dynamixel/servo"
+	"github.com/adammck/dynamixel/servo/ax"
+	"github.com/adammck/dynamixel/servo/xl"
+)
+
+const broadcastID = 0xFE
+
+// Packet is a single packet seen on the bus.
+type Packet struct {
+	Time     time.Time
+	Protocol int
+	ID       int
+
+	// Instruction packets have an instruction. Status packets have an error
+	// (which is usually zero) instead.
+	Status      bool
+	Instruction byte
+	Error       byte
+	Params      []byte
+
+	// Set if the packet was framed correctly, but the checksum didn't match.
+	Corrupt bool
+}
+
+// Transaction is an instruction packet, and any status packets which were sent
+// in response to it. If a status packet is seen without an instruction (e.g.
+// because the sniffer started listening half way through), Instruction is nil.
+type Transaction struct {
+	Instruction *Packet
+	Responses   []*Packet
+}
+
+// Sniffer decodes a stream of bytes from a bus. It's not safe for concurrent
+// use.
+type Sniffer struct {
+
+	// The register map to use for servos with unknown models, by protocol.
+	Defaults map[int]reg.Map
+
+	buf     []byte
+	pending *Transaction
+
+	// The register map of each servo, keyed by protocol and ID.
+	servos map[[2]int]reg.Map
+}
+
+// New returns a Sniffer which assumes that servos are AX-12s or XL-320s,
+// depending on the protocol, until their model number is seen (e.g. in a PING
+// response). Then it uses the registers of that model, if it's registered (see
+// servo.RegisterModel), so import the packages of any other models on the bus.
+func New() *Sniffer {
+	return &Sniffer{
+		Defaults: map[int]reg.Map{
+			1: ax.Registers,
+			2: xl.Registers,
+		},
+		servos: map[[2]int]reg.Map{},
+	}
+}
+
+// Feed decodes the given bytes, which were received at the given time, and
+// returns any transactions which are now complete. A transaction is complete
+// when the next instruction is seen, or when Flush is called.
+func (s *Sniffer) Feed(t time.Time, b []byte) []*Transaction {
+	s.buf = append(s.buf, b...)
+	out := []*Transaction{}
+
+	for {
+		pkt, ok := s.next(t)
+		if !ok {
+			break
+		}
+		if pkt == nil {
+			continue
+		}
+
+		if pkt.Status {
+			if s.pending != nil && s.pending.Instruction.Protocol == pkt.Protocol {
+				s.pending.Responses = append(s.pending.Responses, pkt)
+				continue
+			}
+
+			out = append(out, &Transaction{Responses: []*Packet{pkt}})
+			continue
+		}
+
+		out = append(out, s.Flush()...)
+		s.pending = &Transaction{Instruction: pkt}
+	}
+
+	return out
+}
+
+// Flush returns the pending transaction, if there is one. Call this when the
+// bus has been idle for a while, so the last instruction isn't held forever
+// waiting for a response which might never come.
+func (s *Sniffer) Flush() []*Transaction {
+	if s.pending == nil {
+		return nil
+	}
+
+	t := s.pending
+	s.pending = nil
+	s.learn(t)
+	return []*Transaction{t}
+}
+
+// next frames the next packet from the buffer. Returns false if there aren't
+// enough bytes, or a nil packet if some junk was skipped.
+func (s *Sniffer) next(t time.Time) (*Packet, bool) {
+	for len(s.buf) >= 2 && (s.buf[0] != 0xFF || s.buf[1] != 0xFF) {
+		s.buf = s.buf[1:]
+	}
+
+	if len(s.buf) < 4 {
+		return nil, false
+	}
+
+	if s.buf[2] == 0xFD && s.buf[3] == 0x00 {
+		p, n, err := v2.ParsePacket(s.buf)
+		if err == v2.ErrIncomplete {
+			return nil, false
+		}
+		if p == nil {
+			s.buf = s.buf[1:]
+			return nil, true
+		}
+
+		s.buf = s.buf[n:]
+		pkt := &Packet{Time: t, Protocol: 2, ID: p.ID, Instruction: p.Instruction, Params: p.Params, Corrupt: err != nil}
+		if p.Instruction == v2.Status && len(p.Params) > 0 {
+			pkt.Status = true
+			pkt.Instruction = 0
+			pkt.Error = p.Params[0]
+			pkt.Params = p.Params[1:]
+		}
+
+		return pkt, true
+	}
+
+	p, n, err := v1.ParsePacket(s.buf)
+	if err == v1.ErrIncomplete {
+		return nil, false
+	}
+	if p == nil {
+		s.buf = s.buf[1:]
+		return nil, true
+	}
+
+	s.buf = s.buf[n:]
+	pkt := &Packet{Time: t, Protocol: 1, ID: p.ID, Instruction: p.Instruction, Params: p.Params, Corrupt: err != nil}
+	if s.isStatus1(pkt) {
+		pkt.Status = true
+		pkt.Error = pkt.Instruction
+		pkt.Instruction = 0
+	}
+
+	return pkt, true
+}
+
+// isStatus1 guesses whether a protocol 1 packet is a status packet. They're
+// framed exactly like instructions, so the only way to tell is whether it looks
+// like the response to the pending instruction: from the same ID, and with the
+// expected number of params.
+func (s *Sniffer) isStatus1(pkt *Packet) bool {
+	if s.pending == nil || len(s.pending.Responses) > 0 {
+		return false
+	}
+
+	in := s.pending.Instruction
+	if in.Protocol != 1 || in.ID == broadcastID || in.ID != pkt.ID {
+		return false
+	}
+
+	exp := 0
+	if in.Instruction == v1.ReadData && len(in.Params) == 2 {
+		exp = int(in.Params[1])
+	}
+
+	return len(pkt.Params) == exp
+}
+
+// learn records the model of a servo, if the transaction reveals it.
+func (s *Sniffer) learn(t *Transaction) {
+	in := t.Instruction
+	if len(t.Responses) != 1 || t.Responses[0].Error != 0 {
+		return
+	}
+
+	res := t.Responses[0]
+	model := -1
+
+	switch {
+	case in.Protocol == 2 && in.Instruction == v2.Ping && len(res.Params) >= 2:
+		model = word(res.Params)
+
+	case in.Instruction == v1.ReadData && len(res.Params) >= 2:
+		if addr, _ := readSpan(in); addr == 0 {
+			model = word(res.Params)
+		}
+	}
+
+	if m, ok := servo.LookupModel(in.Protocol, model); ok {
+		s.servos[[2]int{in.Protocol, res.ID}] = m.Registers
+	}
+}
+
+// registers returns the register map of the given servo.
+func (s *Sniffer) registers(proto, ID int) reg.Map {
+	if m, ok := s.servos[[2]int{proto, ID}]; ok {
+		return m
+	}
+
+	return s.Defaults[proto]
+}
+
+// IDs returns the IDs of the servos involved in the transaction, including
+// those addressed by SYNC and BULK instructions.
+func (t *Transaction) IDs() []int {
+	ids := []int{}
+
+	if in := t.Instruction; in != nil {
+		ids = append(ids, in.ID)
+
+		for _, w := range multi(in) {
+			ids = append(ids, w.id)
+		}
+	}
+
+	for _, res := range t.Responses {
+		ids = append(ids, res.ID)
+	}
+
+	return ids
+}
+
+// Latency returns the time between the instruction and the last response, or
+// zero if there was no response.
+func (t *Transaction) Latency() time.Duration {
+	if t.Instruction == nil || len(t.Responses) == 0 {
+		return 0
+	}
+
+	return t.Responses[len(t.Responses)-1].Time.Sub(t.Instruction.Time)
+}
+
+// Format returns a one-line description of the transaction.
+func (s *Sniffer) Format(t *Transaction) string {
+	var pkt *Packet
+	desc := ""
+
+	if in := t.Instruction; in != nil {
+		pkt = in
+		desc = s.describe(in)
+	} else {
+		pkt = t.Responses[0]
+		desc = "STATUS"
+	}
+
+	res := "no response"
+	if len(t.Responses) > 0 {
+		strs := []string{}
+		for _, r := range t.Responses {
+			strs = append(strs, s.describeStatus(t.Instruction, r, len(t.Responses) > 1))
+		}
+
+		res = strings.Join(strs, ", ")
+		if t.Instruction != nil {
+			res = fmt.Sprintf("%s (%s)", res, t.Latency())
+		}
+	}
+
+	return fmt.Sprintf("%s v%d id=%d %s -> %s", pkt.Time.Format("15:04:05.000000"), pkt.Protocol, pkt.ID, desc, res)
+}
+
+// describe returns a description of an instruction packet.
+func (s *Sniffer) describe(pkt *Packet) string {
+	name := v1.InstructionName(pkt.Instruction)
+	if pkt.Protocol == 2 {
+		name = v2.InstructionName(pkt.Instruction)
+	}
+
+	if pkt.Corrupt {
+		name += " (bad checksum)"
+	}
+
+	m := s.registers(pkt.Protocol, pkt.ID)
+
+	switch {
+	case isRead(pkt):
+		addr, n := readSpan(pkt)
+		return fmt.Sprintf("%s %s len=%d", name, names(m, addr, n), n)
+
+	case isWrite(pkt):
+		addr, data := writeSpan(pkt)
+		return fmt.Sprintf("%s %s", name, decode(m, addr, data))
+	}
+
+	ws := multi(pkt)
+	if len(ws) == 0 {
+		return name
+	}
+
+	strs := []string{}
+	for _, w := range ws {
+		m := s.registers(pkt.Protocol, w.id)
+		if w.data == nil {
+			strs = append(strs, fmt.Sprintf("id=%d %s len=%d", w.id, names(m, w.addr, w.n), w.n))
+		} else {
+			strs = append(strs, fmt.Sprintf("id=%d %s", w.id, decode(m, w.addr, w.data)))
+		}
+	}
+
+	return fmt.Sprintf("%s [%s]", name, strings.Join(strs, "; "))
+}
+
+// describeStatus returns a description of a status packet, in the context of
+// the instruction which it's a response to (which may be nil).
+func (s *Sniffer) describeStatus(in *Packet, res *Packet, withID bool) string {
+	str := ""
+
+	switch {
+	case res.Corrupt:
+		str = "bad checksum"
+
+	case res.Error != 0 && res.Protocol == 1:
+		str = v1.DecodeError(res.Error).Error()
+
+	case res.Error != 0:
+		str = v2.DecodeError(res.Error).Error()
+
+	case len(res.Params) == 0:
+		str = "ok"
+
+	default:
+		str = fmt.Sprintf("% X", res.Params)
+		if addr, ok := responseAddr(in, res.ID); ok {
+			str = decode(s.registers(res.Protocol, res.ID), addr, res.Params)
+		}
+	}
+
+	if withID {
+		return fmt.Sprintf("id=%d %s", res.ID, str)
+	}
+
+	return str
+}
+
+// responseAddr returns the address of the data in a response from the given ID
+// to the given instruction, if it's a read.
+func responseAddr(in *Packet, ID int) (int, bool) {
+	if in == nil {
+		return 0, false
+	}
+
+	if isRead(in) {
+		addr, _ := readSpan(in)
+		return addr, true
+	}
+
+	for _, w := range multi(in) {
+		if w.id == ID && w.data == nil {
+			return w.addr, true
+		}
+	}
+
+	return 0, false
+}
+
+func isRead(pkt *Packet) bool {
+	return pkt.Instruction == v1.ReadData && len(pkt.Params) == 2*pkt.Protocol
+}
+
+func isWrite(pkt *Packet) bool {
+	return (pkt.Instruction == v1.WriteData || pkt.Instruction == v1.RegWrite) && len(pkt.Params) > pkt.Protocol
+}
+
+// readSpan returns the address and length of a READ_DATA instruction.
+func readSpan(pkt *Packet) (int, int) {
+	if pkt.Protocol == 1 {
+		return int(pkt.Params[0]), int(pkt.Params[1])
+	}
+
+	return word(pkt.Params[0:]), word(pkt.Params[2:])
+}
+
+// writeSpan returns the address and data of a WRITE_DATA or REG_WRITE.
+func writeSpan(pkt *Packet) (int, []byte) {
+	if pkt.Protocol == 1 {
+		return int(pkt.Params[0]), pkt.Params[1:]
+	}
+
+	return word(pkt.Params[0:]), pkt.Params[2:]
+}
+
+// part is one servo's part of a SYNC or BULK instruction. Data is nil for
+// reads.
+type part struct {
+	id   int
+	addr int
+	n    int
+	data []byte
+}
+
+// multi returns the parts of a SYNC or BULK instruction, or nil if the packet
+// is some other instruction.
+func multi(pkt *Packet) []part {
+	ps := pkt.Params
+	out := []part{}
+
+	switch {
+	case pkt.Protocol == 1 && pkt.Instruction == v1.SyncWrite && len(ps) >= 2:
+		addr, n := int(ps[0]), int(ps[1])
+		for i := 2; i+n+1 <= len(ps); i += n + 1 {
+			out = append(out, part{int(ps[i]), addr, n, ps[i+1 : i+n+1]})
+		}
+
+	case pkt.Protocol == 2 && pkt.Instruction == v2.SyncWrite && len(ps) >= 4:
+		addr, n := word(ps[0:]), word(ps[2:])
+		for i := 4; i+n+1 <= len(ps); i += n + 1 {
+			out = append(out, part{int(ps[i]), addr, n, ps[i+1 : i+n+1]})
+		}
+
+	case pkt.Protocol == 2 && pkt.Instruction == v2.SyncRead && len(ps) >= 4:
+		addr, n := word(ps[0:]), word(ps[2:])
+		for _, id := range ps[4:] {
+			out = append(out, part{int(id), addr, n, nil})
+		}
+
+	case pkt.Protocol == 2 && pkt.Instruction == v2.BulkRead:
+		for i := 0; i+5 <= len(ps); i += 5 {
+			out = append(out, part{int(ps[i]), word(ps[i+1:]), word(ps[i+3:]), nil})
+		}
+
+	case pkt.Protocol == 2 && pkt.Instruction == v2.BulkWrite:
+		for i := 0; i+5 <= len(ps); {
+			n := word(ps[i+3:])
+			if i+5+n > len(ps) {
+				break
+			}
+
+			out = append(out, part{int(ps[i]), word(ps[i+1:]), n, ps[i+5 : i+5+n]})
+			i += 5 + n
+		}
+	}
+
+	return out
+}
+
+// registersIn returns the names of the registers which lie entirely within
+// the given span of the control table, ordered by address.
+func registersIn(m reg.Map, addr, n int) []reg.RegName {
+	out := []reg.RegName{}
+	for name, r := range m {
+		if r.Address >= addr && r.Address+r.Length <= addr+n {
+			out = append(out, name)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return m[out[i]].Address < m[out[j]].Address
+	})
+
+	return out
+}
+
+// names returns the names of the registers in the given span, or its address
+// if there aren't any.
+func names(m reg.Map, addr, n int) string {
+	rs := registersIn(m, addr, n)
+	if len(rs) == 0 {
+		return fmt.Sprintf("0x%02X", addr)
+	}
+
+	strs := []string{}
+	for _, r := range rs {
+		strs = append(strs, r.String())
+	}
+
+	return strings.Join(strs, ",")
+}
+
+// decode returns the value of each register in the data (which starts at the
+// given address), or the raw bytes if there aren't any registers.
+func decode(m reg.Map, addr int, data []byte) string {
+	rs := registersIn(m, addr, len(data))
+	if len(rs) == 0 {
+		return fmt.Sprintf("0x%02X=[% X]", addr, data)
+	}
+
+	strs := []string{}
+	for _, n := range rs {
+		r := m[n]
+		i := r.Address - addr
+		v, _ := r.Decode(data[i : i+r.Length])
+		strs = append(strs, fmt.Sprintf("%s=%d", n, v))
+	}
+
+	return strings.Join(strs, " ")
+}
+
+// word decodes the little-endian uint16 at the start of b.
+func word(b []byte) int {
+	return int(b[0]) | int(b[1])<<8
+}
@@ -0,0 +1,132 @@
+package sniffer
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/adammck/dynamixel/protocol/v1"
+	v2 "github.com/adammck/dynamixel/protocol/v2"
+	_ "github.com/adammck/dynamixel/servo/x"
+	"github.com/stretchr/testify/assert"
+)
+
+var t0 = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func at(ms int) time.Time {
+	return t0.Add(time.Duration(ms) * time.Millisecond)
+}
+
+func TestProto1(t *testing.T) {
+	s := New()
+
+	// Junk before the first header is skipped.
+	b := []byte{0x00, 0x12}
+	b = append(b, (&v1.Packet{ID: 1, Instruction: v1.ReadData, Params: []byte{36, 2}}).Bytes()...)
+	assert.Empty(t, s.Feed(at(0), b))
+
+	// The response is held until the next instruction.
+	assert.Empty(t, s.Feed(at(1), (&v1.Packet{ID: 1, Instruction: 0, Params: []byte{0x00, 0x02}}).Bytes()))
+
+	// An instruction to the same ID which doesn't look like a response.
+	ts := s.Feed(at(2), (&v1.Packet{ID: 1, Instruction: v1.WriteData, Params: []byte{25, 1}}).Bytes())
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, []int{1, 1}, ts[0].IDs())
+		assert.Equal(t, time.Millisecond, ts[0].Latency())
+		assert.Equal(t, "12:00:00.000000 v1 id=1 READ_DATA PresentPosition len=2 -> PresentPosition=512 (1ms)", s.Format(ts[0]))
+	}
+
+	// A status error.
+	s.Feed(at(3), (&v1.Packet{ID: 1, Instruction: 0x20}).Bytes())
+	ts = s.Flush()
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, "12:00:00.002000 v1 id=1 WRITE_DATA Led=1 -> status error: overload (1ms)", s.Format(ts[0]))
+	}
+
+	// Nothing is pending now.
+	assert.Empty(t, s.Flush())
+
+	ts = s.Feed(at(4), (&v1.Packet{ID: 0xFE, Instruction: v1.SyncWrite, Params: []byte{30, 2, 1, 0x00, 0x02, 2, 0xFF, 0x03}}).Bytes())
+	assert.Empty(t, ts)
+	ts = s.Flush()
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, []int{0xFE, 1, 2}, ts[0].IDs())
+		assert.Equal(t, "12:00:00.004000 v1 id=254 SYNC_WRITE [id=1 GoalPosition=512; id=2 GoalPosition=1023] -> no response", s.Format(ts[0]))
+	}
+}
+
+func TestProto2(t *testing.T) {
+	s := New()
+
+	s.Feed(at(0), (&v2.Packet{ID: 1, Instruction: v2.Ping}).Bytes())
+	s.Feed(at(1), (&v2.Packet{ID: 1, Instruction: v2.Status, Params: []byte{0x00, 0x5E, 0x01, 0x1D}}).Bytes())
+	ts := s.Feed(at(2), (&v2.Packet{ID: 0xFE, Instruction: v2.SyncRead, Params: []byte{37, 0, 2, 0, 1, 2}}).Bytes())
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, "12:00:00.000000 v2 id=1 PING -> 5E 01 1D (1ms)", s.Format(ts[0]))
+	}
+
+	s.Feed(at(3), (&v2.Packet{ID: 1, Instruction: v2.Status, Params: []byte{0x00, 0x23, 0x01}}).Bytes())
+	s.Feed(at(4), (&v2.Packet{ID: 2, Instruction: v2.Status, Params: []byte{0x02}}).Bytes())
+	ts = s.Flush()
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, []int{0xFE, 1, 2, 1, 2}, ts[0].IDs())
+		assert.Equal(t, "12:00:00.002000 v2 id=254 SYNC_READ [id=1 PresentPosition len=2; id=2 PresentPosition len=2] -> id=1 PresentPosition=291, id=2 instruction error (2ms)", s.Format(ts[0]))
+	}
+}
+
+func TestLearn(t *testing.T) {
+	s := New()
+
+	// An XM430-W350 would use the XL-320 register map, until its model number
+	// is seen. Then it's looked up in the registry.
+	s.Feed(at(0), (&v2.Packet{ID: 3, Instruction: v2.ReadData, Params: []byte{132, 0, 4, 0}}).Bytes())
+	ts := s.Flush()
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, "12:00:00.000000 v2 id=3 READ_DATA 0x84 len=4 -> no response", s.Format(ts[0]))
+	}
+
+	s.Feed(at(1), (&v2.Packet{ID: 3, Instruction: v2.Ping}).Bytes())
+	s.Feed(at(2), (&v2.Packet{ID: 3, Instruction: v2.Status, Params: []byte{0x00, 0xFC, 0x03, 0x2C}}).Bytes())
+	s.Flush()
+
+	s.Feed(at(3), (&v2.Packet{ID: 3, Instruction: v2.ReadData, Params: []byte{132, 0, 4, 0}}).Bytes())
+	ts = s.Flush()
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, "12:00:00.003000 v2 id=3 READ_DATA PresentPosition len=4 -> no response", s.Format(ts[0]))
+	}
+
+	// Model numbers are per protocol, so an AX-12 (12) on protocol 2 is unknown.
+	s.Feed(at(4), (&v2.Packet{ID: 4, Instruction: v2.Ping}).Bytes())
+	s.Feed(at(5), (&v2.Packet{ID: 4, Instruction: v2.Status, Params: []byte{0x00, 0x0C, 0x00, 0x18}}).Bytes())
+	s.Flush()
+
+	s.Feed(at(6), (&v2.Packet{ID: 4, Instruction: v2.ReadData, Params: []byte{37, 0, 2, 0}}).Bytes())
+	ts = s.Flush()
+	if assert.Len(t, ts, 1) {
+		assert.Equal(t, "12:00:00.006000 v2 id=4 READ_DATA PresentPosition len=2 -> no response", s.Format(ts[0]))
+	}
+}
+
+func TestCorrupt(t *testing.T) {
+	s := New()
+
+	b := (&v1.Packet{ID: 1, Instruction: v1.Ping}).Bytes()
+	b[len(b)-1] ^= 0xFF
+	s.Feed(at(0), b)
+
+	ts := s.Flush()
+	if assert.Len(t, ts, 1) {
+		assert.True(t, ts[0].Instruction.Corrupt)
+		assert.Equal(t, "12:00:00.000000 v1 id=1 PING (bad checksum) -> no response", s.Format(ts[0]))
+	}
+}
+
+func TestOrphanStatus(t *testing.T) {
+	s := New()
+
+	ts := s.Feed(at(0), (&v2.Packet{ID: 1, Instruction: v2.Status, Params: []byte{0x00}}).Bytes())
+	if assert.Len(t, ts, 1) {
+		assert.Nil(t, ts[0].Instruction)
+		assert.Equal(t, []int{1}, ts[0].IDs())
+		assert.Equal(t, "12:00:00.000000 v2 id=1 STATUS -> ok", s.Format(ts[0]))
+	}
+}
@@ -4,8 +4,9 @@ import (
 	"fmt"
 )
 
-// BytesToInt converts a slice of bytes to an int. Only 8- and 16-bit uints are
-// supported, because those are the only thing the control tables contain.
+// BytesToInt converts a little-endian slice of bytes to an int. Only 8-, 16- and
+// 32-bit uints are supported, because those are the only thing the control
+// tables contain.
 func BytesToInt(b []byte) (int, error) {
 
 	switch len(b) {
@@ -15,12 +16,43 @@ func BytesToInt(b []byte) (int, error) {
 	case 2:
 		return int(b[0]) | int(b[1])<<8, nil
 
+	case 4:
+		return int(b[0]) | int(b[1])<<8 | int(b[2])<<16 | int(b[3])<<24, nil
+
 	default:
 		return 0, fmt.Errorf("invalid read length %d", len(b))
 
 	}
 }
 
+// BytesToSignedInt converts a little-endian slice of bytes to an int, treating
+// it as a two's complement signed value of the same width.
+func BytesToSignedInt(b []byte) (int, error) {
+	v, err := BytesToInt(b)
+	if err != nil {
+		return 0, err
+	}
+
+	bits := uint(len(b) * 8)
+	if v&(1<<(bits-1)) != 0 {
+		v -= 1 << bits
+	}
+
+	return v, nil
+}
+
+// IntToBytes converts an int to a little-endian slice of n bytes. Negative
+// values are encoded as two's complement. Bits which don't fit are discarded,
+// so the caller should check that the value is in range first.
+func IntToBytes(v int, n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = Low(v >> uint(8*i))
+	}
+
+	return b
+}
+
 // BoolToInt converts a bool to an int.
 func BoolToInt(b bool) int {
 	if b {
@@ -19,6 +19,8 @@ func TestBytesToInt(t *testing.T) {
 		{[]byte{2, 0}, false, 2},
 		{[]byte{1, 1}, false, 257},
 		{[]byte{2, 2}, false, 514},
+		{[]byte{1, 2, 3, 4}, false, 0x04030201},
+		{[]byte{0xff, 0xff, 0xff, 0xff}, false, 0xffffffff},
 		{[]byte{0, 0, 0}, true, 0},
 	}
 
@@ -32,6 +34,39 @@ func TestBytesToInt(t *testing.T) {
 	}
 }
 
+func TestBytesToSignedInt(t *testing.T) {
+	examples := []struct {
+		input  []byte
+		err    bool
+		output int
+	}{
+		{[]byte{0x7f}, false, 127},
+		{[]byte{0x80}, false, -128},
+		{[]byte{0xff, 0xff}, false, -1},
+		{[]byte{0x00, 0x80}, false, -32768},
+		{[]byte{0x01, 0x02, 0x03, 0x04}, false, 0x04030201},
+		{[]byte{0x0f, 0x2d, 0xfc, 0xff}, false, -250609},
+		{[]byte{0, 0, 0}, true, 0},
+	}
+
+	for _, eg := range examples {
+		act, err := BytesToSignedInt(eg.input)
+		assert.Equal(t, eg.output, act)
+
+		if eg.err {
+			assert.Error(t, err)
+		}
+	}
+}
+
+func TestIntToBytes(t *testing.T) {
+	assert.Equal(t, []byte{0x01}, IntToBytes(1, 1))
+	assert.Equal(t, []byte{0x01, 0x04}, IntToBytes(1025, 2))
+	assert.Equal(t, []byte{0xff, 0xff}, IntToBytes(-1, 2))
+	assert.Equal(t, []byte{0x01, 0x02, 0x03, 0x04}, IntToBytes(0x04030201, 4))
+	assert.Equal(t, []byte{0x0f, 0x2d, 0xfc, 0xff}, IntToBytes(-250609, 4))
+}
+
 func TestBoolToInt(t *testing.T) {
 	assert.Equal(t, 1, BoolToInt(true))
 	assert.Equal(t, 0, BoolToInt(false))